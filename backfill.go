@@ -0,0 +1,116 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const (
+	// 캔들 기준 시각 포맷 (candle_date_time_utc)
+	CANDLE_DATE_TIME_FORMAT = "2006-01-02T15:04:05"
+)
+
+// [Quotation API] 캔들 백필 @ candles/{interval}
+//  [from, to) 구간의 캔들을 200개 제한을 넘어 전부 조회합니다.
+//	to 부터 과거 방향으로 페이지를 넘기며, 겹치는 캔들은 한 번만 담고 시간 오름차순으로 정렬해 반환합니다.
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+//	interval = 캔들 단위
+//	from = 시작 시각 (inclusive)
+//	to = 끝 시각 (exclusive)
+func (o *Upbit) CandlesBackfill(market string, interval Interval, from time.Time, to time.Time) UpbitCandles {
+	return o.CandlesBackfillContext(context.Background(), market, interval, from, to)
+}
+
+// CandlesBackfill 의 context 버전
+func (o *Upbit) CandlesBackfillContext(ctx context.Context, market string, interval Interval, from time.Time, to time.Time) UpbitCandles {
+	var res UpbitCandles
+	res.Common = o.CandlesBackfillFuncContext(ctx, market, interval, from, to, func(page []Candle) error {
+		res.Response = append(res.Response, page...)
+		return nil
+	})
+	sort.Slice(res.Response, func(i, j int) bool {
+		return res.Response[i].CandleDateTimeUtc.Before(res.Response[j].CandleDateTimeUtc)
+	})
+	return res
+}
+
+// [Quotation API] 캔들 백필 스트리밍 @ candles/{interval}
+//  CandlesBackfill 과 같지만 페이지를 받을 때마다 fn 을 호출합니다.
+//	페이지는 최신 구간부터 과거 순서로 전달되며, 한 페이지 안에서는 시간 오름차순입니다.
+//	fn 이 error 를 반환하면 중단하고 그 error 를 Error 로 반환합니다.
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+//	interval = 캔들 단위
+//	from = 시작 시각 (inclusive)
+//	to = 끝 시각 (exclusive)
+//	fn = 페이지 처리 함수
+func (o *Upbit) CandlesBackfillFunc(market string, interval Interval, from time.Time, to time.Time, fn func(page []Candle) error) UpbitCommonBlock {
+	return o.CandlesBackfillFuncContext(context.Background(), market, interval, from, to, fn)
+}
+
+// CandlesBackfillFunc 의 context 버전
+//  요청 수 제한과 429 재시도는 클라이언트의 RateLimiter, RetryPolicy 를 따릅니다.
+//	ctx 가 끝나면 대기 중이던 요청을 멈추고 ctx.Err() 를 Error 로 반환합니다.
+func (o *Upbit) CandlesBackfillFuncContext(ctx context.Context, market string, interval Interval, from time.Time, to time.Time, fn func(page []Candle) error) UpbitCommonBlock {
+	var common UpbitCommonBlock
+	if !from.Before(to) {
+		common.Error = newValidationError("From", "From must be before To!")
+		return common
+	}
+
+	seen := map[int64]bool{}
+	cursor := to.UTC()
+	for {
+		if err := ctx.Err(); err != nil {
+			common.Error = err
+			return common
+		}
+		res := o.CandlesContext(ctx, market, interval, cursor, 200)
+		common = res.Common
+		if res.Common.StatusCode == 200 && len(res.Response) <= 0 {
+			// 더 이상 과거 캔들이 없음
+			common.Error = nil
+			return common
+		}
+		if res.Common.Error != nil {
+			return common
+		}
+
+		oldest := cursor
+		var page []Candle
+		for _, candle := range res.Response {
+			t := candle.CandleDateTimeUtc
+			if t.Before(oldest) {
+				oldest = t
+			}
+			if t.Before(from) || !t.Before(to) || seen[t.Unix()] {
+				continue
+			}
+			seen[t.Unix()] = true
+			page = append(page, candle)
+		}
+
+		if len(page) > 0 {
+			sort.Slice(page, func(i, j int) bool {
+				return page[i].CandleDateTimeUtc.Before(page[j].CandleDateTimeUtc)
+			})
+			if err := fn(page); err != nil {
+				common.Error = err
+				return common
+			}
+		}
+
+		if !oldest.After(from) || !oldest.Before(cursor) || len(res.Response) < 200 {
+			return common
+		}
+		cursor = oldest
+	}
+}
@@ -0,0 +1,46 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// CandlesBackfill 테스트
+//  200개 제한을 넘는 1분 캔들(5시간)을 중복 없이 오름차순으로 가져와야 함
+func TestUpbitCandlesBackfill(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	to := testNow.Add(-time.Hour)
+	from := to.Add(-5 * time.Hour)
+	x := upbit.CandlesBackfill("KRW-BTC", INTERVAL_MINUTE_1, from, to)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) <= 200 || len(x.Response) > 300 {
+		t.Errorf("TestUpbitCandlesBackfill | Status:[%d], Count:[%d], candlesBackfillErr:[%s]", x.Common.StatusCode, len(x.Response), x.Common.Error)
+	}
+	for i := 1; i < len(x.Response); i++ {
+		if !x.Response[i-1].CandleDateTimeUtc.Before(x.Response[i].CandleDateTimeUtc) {
+			t.Errorf("TestUpbitCandlesBackfill | [%s] is not before [%s]", x.Response[i-1].CandleDateTimeUtc, x.Response[i].CandleDateTimeUtc)
+		}
+	}
+
+	// 429 는 클라이언트 RetryPolicy 로 한 번만 다시 요청
+	server.FailNext("/v1/candles/minutes/1", 1, 429, "too_many_requests", "Too many requests")
+	before := len(server.Requests())
+	x = upbit.CandlesBackfill("KRW-BTC", INTERVAL_MINUTE_1, to.Add(-time.Hour), to)
+	if x.Common.Error != nil || len(x.Response) != 60 || len(server.Requests())-before != 2 {
+		t.Errorf("TestUpbitCandlesBackfill | Count:[%d], Requests:[%d], candlesBackfillErr:[%s]", len(x.Response), len(server.Requests())-before, x.Common.Error)
+	}
+
+	x = upbit.CandlesBackfill("KRW-BTC", INTERVAL_MINUTE_1, to, from)
+	if x.Common.StatusCode != 0 || x.Common.Error == nil {
+		t.Errorf("TestUpbitCandlesBackfill | Status:[%d], candlesBackfillErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
@@ -0,0 +1,227 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// (결과, error) 를 반환하는 클라이언트
+//  Upbit 의 Response, Common 결과 대신 Go 관례대로 (T, error) 를 반환합니다.
+//	응답 메타데이터가 필요하면 WithResponseInfo 를 넘기세요.
+//	여러 번 요청하는 메서드의 ResponseInfo 는 CandlesBackfill 은 마지막 요청, CancelOpenOrders 는 목록의 마지막 취소 요청 기준입니다.
+//	ResponseInfo 는 호출이 끝난 뒤에만 읽으세요.
+type Client struct {
+	upbit *Upbit
+}
+
+// Initialization
+// Params:
+//	upbit = 요청에 사용할 Upbit (키, HTTP 클라이언트, 호스트 설정을 그대로 사용)
+func NewClient(upbit *Upbit) *Client {
+	return &Client{upbit: upbit}
+}
+
+// 내부 Upbit 취득
+func (c *Client) Upbit() *Upbit {
+	return c.upbit
+}
+
+// 응답 메타데이터
+type ResponseInfo struct {
+	// HTTP Status code
+	StatusCode int
+	// 응답 헤더
+	Header http.Header
+	// 요청 ID (X-Request-Id, Request-Id 헤더)
+	RequestId string
+	// Remaining-Req 헤더 원문 (ex. group=default; min=1800; sec=29)
+	RemainingReq string
+	// 요청 수 제한 그룹 (ex. default, market, candles, order)
+	RemainingReqGroup string
+	// 이번 분에 남은 요청 수
+	RemainingReqMin int
+	// 이번 초에 남은 요청 수
+	RemainingReqSec int
+	// 요청부터 응답 본문을 받을 때까지 걸린 시간 (마지막 시도 기준)
+	Latency time.Duration
+	// 요청 횟수 (재시도 포함)
+	Attempts int
+}
+
+// ResponseInfo context key
+type responseInfoKey struct{}
+
+// 응답으로 ResponseInfo 채우기
+func (info *ResponseInfo) fill(res *http.Response, latency time.Duration) {
+	info.StatusCode = res.StatusCode
+	info.Header = res.Header
+	info.RequestId = res.Header.Get("X-Request-Id")
+	if info.RequestId == "" {
+		info.RequestId = res.Header.Get("Request-Id")
+	}
+	info.RemainingReq = res.Header.Get("Remaining-Req")
+	info.RemainingReqGroup, info.RemainingReqMin, info.RemainingReqSec, _ = parseRemainingReq(info.RemainingReq)
+	info.Latency = latency
+}
+
+// Remaining-Req 헤더 해석
+//  "group=default; min=1800; sec=29" 형태입니다. min 이 없으면 -1 을 반환합니다.
+func parseRemainingReq(header string) (group string, min int, sec int, ok bool) {
+	if header == "" {
+		return "", 0, 0, false
+	}
+	min, sec = -1, -1
+	for _, part := range strings.Split(header, ";") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "group":
+			group = kv[1]
+		case "min":
+			min, _ = strconv.Atoi(kv[1])
+		case "sec":
+			sec, _ = strconv.Atoi(kv[1])
+		}
+	}
+	return group, min, sec, group != "" && sec >= 0
+}
+
+// 호출 옵션
+type CallOption func(ctx context.Context) context.Context
+
+// 응답 메타데이터 받기
+//  호출이 끝나면 info 에 Status code, 헤더, 요청 수 제한, 걸린 시간이 채워집니다.
+func WithResponseInfo(info *ResponseInfo) CallOption {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, responseInfoKey{}, info)
+	}
+}
+
+// 호출 옵션 적용
+func applyCallOptions(ctx context.Context, opts []CallOption) context.Context {
+	for _, opt := range opts {
+		ctx = opt(ctx)
+	}
+	return ctx
+}
+
+// [Exchange API] 전체 계좌 조회 @ accounts
+func (c *Client) Accounts(ctx context.Context, opts ...CallOption) ([]UpbitAccountBlock, error) {
+	res := c.upbit.AccountsContext(applyCallOptions(ctx, opts))
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 주문 가능 정보 @ orders/chance
+func (c *Client) OrdersChance(ctx context.Context, bidCurrencyTicker string, AskCurrencyTicker string, opts ...CallOption) (UpbitOrdersChanceBlock, error) {
+	res := c.upbit.OrdersChanceContext(applyCallOptions(ctx, opts), bidCurrencyTicker, AskCurrencyTicker)
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 개별 주문 조회 @ order
+func (c *Client) Order(ctx context.Context, opt OrderOption, opts ...CallOption) (UpbitOrderBlock, error) {
+	res := c.upbit.OrderContext(applyCallOptions(ctx, opts), opt)
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 주문 리스트 조회 @ orders
+func (c *Client) Orders(ctx context.Context, opt OrdersOption, opts ...CallOption) ([]UpbitOrderBlock, error) {
+	res := c.upbit.OrdersContext(applyCallOptions(ctx, opts), opt)
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 주문하기 @ orders
+func (c *Client) PlaceOrder(ctx context.Context, opt PlaceOrderOption, opts ...CallOption) (UpbitOrderBlock, error) {
+	res := c.upbit.PlaceOrderContext(applyCallOptions(ctx, opts), opt)
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 주문 취소 접수 @ order
+func (c *Client) CancelOrder(ctx context.Context, opt OrderOption, opts ...CallOption) (UpbitOrderBlock, error) {
+	res := c.upbit.CancelOrderContext(applyCallOptions(ctx, opts), opt)
+	return res.Response, res.Common.Error
+}
+
+// [Exchange API] 미체결 주문 일괄 취소
+//  주문별 취소 결과는 각 UpbitCancelOrderBlock.Result 에 담깁니다.
+func (c *Client) CancelOpenOrders(ctx context.Context, market string, side OrderSide, opts ...CallOption) ([]UpbitCancelOrderBlock, error) {
+	res := c.upbit.CancelOpenOrdersContext(applyCallOptions(ctx, opts), market, side)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 마켓 코드 조회 @ market/all
+func (c *Client) MarketAll(ctx context.Context, isDetails bool, opts ...CallOption) ([]UpbitMarketAllBlock, error) {
+	res := c.upbit.MarketAllContext(applyCallOptions(ctx, opts), isDetails)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 캔들 @ candles/{interval}
+func (c *Client) Candles(ctx context.Context, market string, interval Interval, to time.Time, count int, opts ...CallOption) ([]Candle, error) {
+	res := c.upbit.CandlesContext(applyCallOptions(ctx, opts), market, interval, to, count)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 초(Second) 캔들 @ candles/seconds
+func (c *Client) CandlesSeconds(ctx context.Context, market string, to time.Time, count int, opts ...CallOption) ([]UpbitCandlesSecondsBlock, error) {
+	res := c.upbit.CandlesSecondsContext(applyCallOptions(ctx, opts), market, to, count)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 분(Minute) 캔들 @ candles/minutes/{unit}
+func (c *Client) CandlesMinutes(ctx context.Context, unit int, market string, to time.Time, count int, opts ...CallOption) ([]UpbitCandlesMinutesBlock, error) {
+	res := c.upbit.CandlesMinutesContext(applyCallOptions(ctx, opts), unit, market, to, count)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 일(Day) 캔들 @ candles/days
+func (c *Client) CandlesDays(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string, opts ...CallOption) (UpbitCandlesDaysBlock, error) {
+	res := c.upbit.CandlesDaysContext(applyCallOptions(ctx, opts), market, to, count, convertingPriceUnit)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 주(Week) 캔들 @ candles/weeks
+func (c *Client) CandlesWeeks(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string, opts ...CallOption) ([]UpbitCandlesWeeksBlock, error) {
+	res := c.upbit.CandlesWeeksContext(applyCallOptions(ctx, opts), market, to, count, convertingPriceUnit)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 월(Month) 캔들 @ candles/months
+func (c *Client) CandlesMonths(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string, opts ...CallOption) ([]UpbitCandlesMonthsBlock, error) {
+	res := c.upbit.CandlesMonthsContext(applyCallOptions(ctx, opts), market, to, count, convertingPriceUnit)
+	return res.Response, res.Common.Error
+}
+
+// 기간 캔들 백필
+//  [from, to) 구간의 캔들을 오름차순으로 반환합니다.
+func (c *Client) CandlesBackfill(ctx context.Context, market string, interval Interval, from time.Time, to time.Time, opts ...CallOption) ([]Candle, error) {
+	res := c.upbit.CandlesBackfillContext(applyCallOptions(ctx, opts), market, interval, from, to)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 최근 체결 내역 @ trades/ticks
+func (c *Client) TradesTicks(ctx context.Context, market string, to string, count int, cursor string, daysAgo int, opts ...CallOption) ([]UpbitTradesTicksBlock, error) {
+	res := c.upbit.TradesTicksContext(applyCallOptions(ctx, opts), market, to, count, cursor, daysAgo)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 현재가 정보 @ ticker
+func (c *Client) Ticker(ctx context.Context, markets []string, opts ...CallOption) ([]UpbitTickerBlock, error) {
+	res := c.upbit.TickerContext(applyCallOptions(ctx, opts), markets)
+	return res.Response, res.Common.Error
+}
+
+// [Quotation API] 호가 정보 조회 @ orderbook
+func (c *Client) Orderbook(ctx context.Context, markets []string, opts ...CallOption) ([]UpbitOrderbookBlock, error) {
+	res := c.upbit.OrderbookContext(applyCallOptions(ctx, opts), markets)
+	return res.Response, res.Common.Error
+}
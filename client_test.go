@@ -0,0 +1,77 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// Client 테스트
+//  (결과, error) 를 반환하고 ResponseInfo 가 채워져야 함
+func TestUpbitClient(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	client := NewClient(server.NewUpbit())
+	ctx := context.Background()
+	var info ResponseInfo
+	accounts, err := client.Accounts(ctx, WithResponseInfo(&info))
+	if err != nil || len(accounts) != 2 {
+		t.Errorf("TestUpbitClient | Count:[%d], accountsErr:[%s]", len(accounts), err)
+	}
+	if info.StatusCode != 200 || info.RemainingReqGroup != "default" || info.RemainingReqMin != 1800 || info.RemainingReqSec != 29 || info.Latency <= 0 {
+		t.Errorf("TestUpbitClient | ResponseInfo:[%+v]", info)
+	}
+
+	_, err = client.Order(ctx, OrderOption{Uuid: "TEST"})
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("TestUpbitClient | orderErr:[%s]", err)
+	}
+}
+
+// Client 일괄 취소 테스트
+//  동시에 보내는 취소 요청이 ResponseInfo 를 함께 쓰지 않아야 함 (go test -race)
+func TestUpbitClientCancelOpenOrders(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	var info ResponseInfo
+	orders, err := NewClient(server.NewUpbit()).CancelOpenOrders(context.Background(), "KRW-BTC", "", WithResponseInfo(&info))
+	if err != nil || len(orders) < 2 {
+		t.Fatalf("TestUpbitClientCancelOpenOrders | Count:[%d], cancelOpenOrdersErr:[%s]", len(orders), err)
+	}
+	for _, order := range orders {
+		if order.Result.Common.Error != nil {
+			t.Errorf("TestUpbitClientCancelOpenOrders | Uuid:[%s], cancelOrderErr:[%s]", order.Uuid, order.Result.Common.Error)
+		}
+	}
+	if info.StatusCode != 200 || info.Attempts != 1 || info.RemainingReqGroup != "default" {
+		t.Errorf("TestUpbitClientCancelOpenOrders | ResponseInfo:[%+v]", info)
+	}
+}
+
+// 디코딩 오류 테스트
+//  잘못된 응답 본문은 DecodeError 로 반환되어야 함
+func TestUpbitDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":"not a number"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(NewUpbit("", WithHTTPClient(server.Client()), WithBaseURL(server.URL)))
+	_, err := client.Ticker(context.Background(), []string{"KRW-BTC"})
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || len(decodeErr.Body) <= 0 {
+		t.Errorf("TestUpbitDecodeError | tickerErr:[%s]", err)
+	}
+}
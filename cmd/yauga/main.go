@@ -0,0 +1,75 @@
+package main
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+)
+
+const usage = `Usage:
+  yauga accounts                           전체 계좌 조회 (YAUGA_ACCESS_KEY, YAUGA_SECRECT_KEY 필요)
+  yauga markets                            마켓 코드 조회
+  yauga ticker <market>...                 현재가 정보 (ex. KRW-BTC KRW-ETH)
+  yauga candles <market> <interval> [count] 캔들 조회 (ex. KRW-BTC minutes/1 10)
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	upbit := yauga.NewUpbit(os.Getenv("YAUGA_ACCESS_KEY"))
+	upbit.SetSecretKey(os.Getenv("YAUGA_SECRECT_KEY"))
+
+	var response interface{}
+	var common yauga.UpbitCommonBlock
+	args := os.Args[2:]
+	switch os.Args[1] {
+	case "accounts":
+		res := upbit.Accounts()
+		response, common = res.Response, res.Common
+	case "markets":
+		res := upbit.MarketAll(true)
+		response, common = res.Response, res.Common
+	case "ticker":
+		res := upbit.Ticker(args)
+		response, common = res.Response, res.Common
+	case "candles":
+		if len(args) < 2 {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+		count := 1
+		if len(args) > 2 {
+			var err error
+			if count, err = strconv.Atoi(args[2]); err != nil || count <= 0 {
+				fmt.Fprint(os.Stderr, usage)
+				os.Exit(2)
+			}
+		}
+		res := upbit.Candles(args[0], yauga.Interval(args[1]), time.Time{}, count)
+		response, common = res.Response, res.Common
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if common.Error != nil {
+		fmt.Fprintf(os.Stderr, "Status:[%d], Error:[%s]\n", common.StatusCode, common.Error)
+		os.Exit(1)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(response)
+}
@@ -0,0 +1,369 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// 반올림 방식
+type RoundingMode int
+
+const (
+	// 가장 가까운 값 (0.5 는 0 에서 먼 쪽)
+	ROUND_HALF_UP RoundingMode = iota
+	// 작은 쪽 (음의 무한대 방향)
+	ROUND_DOWN
+	// 큰 쪽 (양의 무한대 방향)
+	ROUND_UP
+	// 0 방향 (버림)
+	ROUND_TRUNCATE
+)
+
+const (
+	// 해석할 수 있는 지수, 소수점 아래 자릿수의 최대 크기
+	//  "1e30000000" 같은 값으로 아주 큰 수를 만들지 않도록 제한합니다.
+	DECIMAL_MAX_SCALE = 1000
+)
+
+// 0 으로 나눔 (Decimal.Div)
+var ErrDivisionByZero = errors.New("yauga: decimal division by zero")
+
+// 십진 소수 [NumberString]
+//  업비트가 문자열로 보내는 금액, 수량, 비율을 float64 로 바꾸지 않고 그대로 담아 오차 없이 계산합니다.
+//	값은 unscaled × 10^-scale 이며, 받은 자릿수(ex. "1000000.0")를 그대로 유지합니다.
+//	영값(Decimal{})은 0 이지만 JSON 으로는 null 을 뜻합니다. (ex. 시장가 매도 주문의 price)
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// 정수로 만들기
+func NewDecimalFromInt(v int64) Decimal {
+	return Decimal{unscaled: big.NewInt(v)}
+}
+
+// float64 로 만들기
+//  v 를 표현하는 가장 짧은 십진수를 사용합니다. (ex. 0.1 -> "0.1")
+func NewDecimalFromFloat(v float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+// 문자열 해석
+//  "123", "-0.00012345", "1e-8" 형태를 받습니다.
+//	지수나 결과 자릿수가 ±DECIMAL_MAX_SCALE 를 넘으면 오류입니다.
+// Params:
+//	s = 십진수 문자열
+func ParseDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	var exp int64
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("yauga: invalid decimal %q", s)
+		}
+		exp = e
+		str = str[:i]
+	}
+	var scale int64
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		scale = int64(len(str) - i - 1)
+		str = str[:i] + str[i+1:]
+	}
+	digits := strings.TrimLeft(str, "+-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" || len(str)-len(digits) > 1 {
+		return Decimal{}, fmt.Errorf("yauga: invalid decimal %q", s)
+	}
+	unscaled, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("yauga: invalid decimal %q", s)
+	}
+	if exp < -DECIMAL_MAX_SCALE || exp > DECIMAL_MAX_SCALE {
+		return Decimal{}, fmt.Errorf("yauga: invalid decimal %q", s)
+	}
+	scale -= exp
+	if scale < -DECIMAL_MAX_SCALE || scale > DECIMAL_MAX_SCALE {
+		return Decimal{}, fmt.Errorf("yauga: invalid decimal %q", s)
+	}
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(int32(-scale)))
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: int32(scale)}, nil
+}
+
+// 문자열 해석 (실패하면 panic)
+//  상수처럼 쓰는 값에만 사용하세요.
+func MustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// 10^n
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// unscaled (영값이면 0)
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// scale 을 늘린 unscaled
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale <= d.scale {
+		return new(big.Int).Set(d.int())
+	}
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+// 두 값의 큰 scale
+func maxScale(a Decimal, b Decimal) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+// 덧셈
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// 뺄셈
+func (d Decimal) Sub(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Sub(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// 곱셈
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// 나눗셈
+//  소수점 아래 places 자리까지 mode 로 반올림합니다.
+//	o 가 0 이면 영값과 ErrDivisionByZero 를 반환합니다.
+// Params:
+//	o = 나누는 수
+//	places = 소수점 아래 자릿수 (0 이상)
+//	mode = 반올림 방식
+func (d Decimal) Div(o Decimal, places int32, mode RoundingMode) (Decimal, error) {
+	if o.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+	if places < 0 {
+		places = 0
+	}
+	// d / o = (d.unscaled × 10^(places + o.scale)) / (o.unscaled × 10^d.scale) × 10^-places
+	num := new(big.Int).Mul(d.int(), pow10(places+o.scale))
+	den := new(big.Int).Mul(o.int(), pow10(d.scale))
+	return roundQuo(num, den, places, mode), nil
+}
+
+// num / den 을 mode 로 반올림한 값 × 10^-scale
+func roundQuo(num *big.Int, den *big.Int, scale int32, mode RoundingMode) Decimal {
+	if den.Sign() < 0 {
+		num, den = new(big.Int).Neg(num), new(big.Int).Neg(den)
+	}
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	switch mode {
+	case ROUND_DOWN:
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	case ROUND_UP:
+		if r.Sign() > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	case ROUND_HALF_UP:
+		if new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(den) >= 0 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	}
+	if scale < 0 {
+		return Decimal{unscaled: q.Mul(q, pow10(-scale))}
+	}
+	return Decimal{unscaled: q, scale: scale}
+}
+
+// 소수점 아래 places 자리로 반올림
+//  places 가 음수면 정수 자리에서 반올림합니다. (ex. -3 = 천 단위)
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places >= d.scale {
+		return d
+	}
+	return roundQuo(d.int(), pow10(d.scale-places), places, mode)
+}
+
+// step 의 배수로 반올림 (ex. 호가 단위)
+//  step 이 0 이하면 그대로 반환합니다.
+func (d Decimal) RoundStep(step Decimal, mode RoundingMode) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	scale := maxScale(d, step)
+	n := roundQuo(d.rescale(scale), step.rescale(scale), 0, mode)
+	return n.Mul(step)
+}
+
+// 부호 반전
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// 절댓값
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// 비교 (d < o 이면 -1, 같으면 0, d > o 이면 1)
+//  자릿수는 보지 않습니다. (ex. "1.0" == "1")
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d, o)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// 부호 (-1, 0, 1)
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// 0 인지 확인
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// 값이 같은지 확인
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// d < o
+func (d Decimal) LessThan(o Decimal) bool {
+	return d.Cmp(o) < 0
+}
+
+// d > o
+func (d Decimal) GreaterThan(o Decimal) bool {
+	return d.Cmp(o) > 0
+}
+
+// 작은 값
+func MinDecimal(a Decimal, b Decimal) Decimal {
+	if b.LessThan(a) {
+		return b
+	}
+	return a
+}
+
+// 큰 값
+func MaxDecimal(a Decimal, b Decimal) Decimal {
+	if b.GreaterThan(a) {
+		return b
+	}
+	return a
+}
+
+// 소수점 아래 자릿수
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// 문자열 (ex. "0.00012345")
+//  받은 자릿수를 유지합니다.
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(s); pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	}
+	if d.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// 소수점 아래 places 자리 문자열 (ex. StringFixed(2) = "1.50")
+//  자릿수가 넘치면 ROUND_HALF_UP 으로 반올림합니다.
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	d = d.Round(places, ROUND_HALF_UP)
+	return Decimal{unscaled: d.rescale(places), scale: places}.String()
+}
+
+// float64 변환 (가장 가까운 값)
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// int64 변환 (소수점 아래 버림)
+func (d Decimal) Int64() int64 {
+	return d.Round(0, ROUND_TRUNCATE).rescale(0).Int64()
+}
+
+// big.Rat 변환
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(d.scale))
+}
+
+// JSON 문자열로 변환 (영값은 null)
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.unscaled == nil {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// JSON 해석
+//  문자열("0.1"), 숫자(0.1), null 을 받습니다. null, "" 는 영값이 됩니다.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return err
+		}
+		if unquoted == "" {
+			*d = Decimal{}
+			return nil
+		}
+		s = unquoted
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
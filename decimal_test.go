@@ -0,0 +1,84 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// Decimal 테스트
+//  자릿수를 잃지 않고 계산, 반올림, JSON 변환해야 함
+func TestUpbitDecimal(t *testing.T) {
+	third, err := MustDecimal("10").Div(MustDecimal("3"), 8, ROUND_DOWN)
+	if err != nil {
+		t.Errorf("TestUpbitDecimal | divErr:[%s]", err)
+	}
+	cases := []struct {
+		got  Decimal
+		want string
+	}{
+		{MustDecimal("0.00012345"), "0.00012345"},
+		{MustDecimal("1000000.0"), "1000000.0"},
+		{MustDecimal("1e-8"), "0.00000001"},
+		{MustDecimal("0.1").Add(MustDecimal("0.2")), "0.3"},
+		{MustDecimal("0.00012345").Sub(MustDecimal("0.00012345")), "0.00000000"},
+		{MustDecimal("50000000").Mul(MustDecimal("0.00012345")), "6172.50000000"},
+		{third, "3.33333333"},
+		{MustDecimal("-2.5").Round(0, ROUND_HALF_UP), "-3"},
+		{MustDecimal("-2.5").Round(0, ROUND_DOWN), "-3"},
+		{MustDecimal("-2.5").Round(0, ROUND_TRUNCATE), "-2"},
+		{MustDecimal("1234.5").Round(-2, ROUND_UP), "1300"},
+		{MustDecimal("50001234").RoundStep(MustDecimal("1000"), ROUND_DOWN), "50001000"},
+		{MustDecimal("512.34").RoundStep(MustDecimal("0.1"), ROUND_HALF_UP), "512.3"},
+		{NewDecimalFromFloat(0.1), "0.1"},
+		{NewDecimalFromInt(-5), "-5"},
+	}
+	for i, c := range cases {
+		if c.got.String() != c.want {
+			t.Errorf("TestUpbitDecimal | Case:[%d], Decimal:[%s], Want:[%s]", i, c.got, c.want)
+		}
+	}
+	if !MustDecimal("1.0").Equal(MustDecimal("1")) || !MustDecimal("0.9").LessThan(MustDecimal("1")) || MustDecimal("1.5").Int64() != 1 || MustDecimal("1.5").Float64() != 1.5 {
+		t.Errorf("TestUpbitDecimal | Compare or convert was wrong")
+	}
+	if MustDecimal("1.5").StringFixed(3) != "1.500" || MustDecimal("1.5555").StringFixed(2) != "1.56" {
+		t.Errorf("TestUpbitDecimal | StringFixed was wrong")
+	}
+	if x, err := MustDecimal("1").Div(Decimal{}, 8, ROUND_DOWN); !errors.Is(err, ErrDivisionByZero) || !x.IsZero() {
+		t.Errorf("TestUpbitDecimal | Div by zero:[%s], divErr:[%v]", x, err)
+	}
+	for _, v := range []string{"1.2.3", "0.1e-2147483647", "1e30000000", "1e1001", "0." + strings.Repeat("0", 1000) + "1"} {
+		if x, err := ParseDecimal(v); err == nil {
+			t.Errorf("TestUpbitDecimal | ParseDecimal accepted %.20s, Decimal:[%.20s]", v, x)
+		}
+	}
+	var huge Decimal
+	if err := json.Unmarshal([]byte(`"1e30000000"`), &huge); err == nil {
+		t.Errorf("TestUpbitDecimal | Unmarshal accepted 1e30000000")
+	}
+
+	var block struct {
+		Balance  Decimal `json:"balance"`
+		MinTotal Decimal `json:"min_total"`
+		Price    Decimal `json:"price"`
+	}
+	if err := json.Unmarshal([]byte(`{"balance":"0.00012345","min_total":5000,"price":null}`), &block); err != nil {
+		t.Fatalf("TestUpbitDecimal | unmarshalErr:[%s]", err)
+	}
+	if block.Balance.String() != "0.00012345" || block.MinTotal.String() != "5000" || !block.Price.IsZero() {
+		t.Errorf("TestUpbitDecimal | Balance:[%s], MinTotal:[%s], Price:[%s]", block.Balance, block.MinTotal, block.Price)
+	}
+	out, _ := json.Marshal(block)
+	if string(out) != `{"balance":"0.00012345","min_total":"5000","price":null}` {
+		t.Errorf("TestUpbitDecimal | JSON:[%s]", out)
+	}
+}
@@ -1,2 +1,3 @@
 go get -u github.com/golang-jwt/jwt
-go get github.com/google/uuid
\ No newline at end of file
+go get github.com/google/uuid
+go get github.com/gorilla/websocket
\ No newline at end of file
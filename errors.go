@@ -0,0 +1,151 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 업비트 오류 (errors.Is 로 비교)
+//  Name 이나 StatusCode 가 같은 APIError 와 일치합니다.
+var (
+	// 매수 가능 금액 부족
+	ErrInsufficientFundsBid = &APIError{Name: "insufficient_funds_bid"}
+	// 매도 가능 수량 부족
+	ErrInsufficientFundsAsk = &APIError{Name: "insufficient_funds_ask"}
+	// 최소 매수 금액 미만
+	ErrUnderMinTotalBid = &APIError{Name: "under_min_total_bid"}
+	// 최소 매도 금액 미만
+	ErrUnderMinTotalAsk = &APIError{Name: "under_min_total_ask"}
+	// 잘못된 매수 주문 가격 (호가 단위)
+	ErrInvalidPriceBid = &APIError{Name: "invalid_price_bid"}
+	// 잘못된 매도 주문 가격 (호가 단위)
+	ErrInvalidPriceAsk = &APIError{Name: "invalid_price_ask"}
+	// 파라미터 오류
+	ErrValidation = &APIError{Name: "validation_error"}
+	// 중복된 identifier
+	ErrDuplicatedIdentifier = &APIError{Name: "duplicated_identifier"}
+	// 주문을 찾지 못함
+	ErrOrderNotFound = &APIError{Name: "order_not_found"}
+	// 마켓 없음
+	ErrMarketDoesNotExist = &APIError{Name: "market_does_not_exist"}
+	// query_hash 불일치
+	ErrInvalidQueryPayload = &APIError{Name: "invalid_query_payload"}
+	// JWT 서명 검증 실패
+	ErrJwtVerification = &APIError{Name: "jwt_verification"}
+	// 만료된 access key
+	ErrExpiredAccessKey = &APIError{Name: "expired_access_key"}
+	// 잘못된 access key
+	ErrInvalidAccessKey = &APIError{Name: "invalid_access_key"}
+	// 이미 사용한 nonce
+	ErrNonceUsed = &APIError{Name: "nonce_used"}
+	// 허용되지 않은 IP
+	ErrNoAuthorizationIP = &APIError{Name: "no_authorization_i_p"}
+	// 권한 없는 기능
+	ErrOutOfScope = &APIError{Name: "out_of_scope"}
+	// 요청 수 제한 초과 (HTTP 429)
+	ErrTooManyRequests = &APIError{StatusCode: http.StatusTooManyRequests, Name: "too_many_requests"}
+	// 업비트 서버 오류 (HTTP 5xx)
+	ErrServerError = errors.New("upbit server error")
+)
+
+// 업비트 API 오류
+//  2xx 가 아닌 응답은 이 타입으로 Common.Error 에 담깁니다.
+//	errors.Is(err, yauga.ErrInsufficientFundsBid) 처럼 종류를 비교하거나 errors.As 로 꺼내 쓸 수 있습니다.
+type APIError struct {
+	// HTTP Status code
+	StatusCode int
+	// 업비트 오류 이름 (ex. insufficient_funds_bid)
+	Name string
+	// 업비트 오류 메시지
+	Message string
+	// 응답 본문
+	Body []byte
+}
+
+// 응답으로 API 오류 만들기
+//  업비트 오류 Block 이 아닌 본문(ex. 429 의 텍스트 응답)은 Message 에 그대로 담습니다.
+// Params:
+//	statusCode = HTTP Status code
+//	body = 응답 본문
+func ParseAPIError(statusCode int, body []byte) *APIError {
+	e := &APIError{StatusCode: statusCode, Body: body}
+	var errorBlock UpbitErrorResponse
+	if json.Unmarshal(body, &errorBlock) == nil && errorBlock.ErrorBlock.Name != "" {
+		e.Name = errorBlock.ErrorBlock.Name
+		e.Message = errorBlock.ErrorBlock.Message
+	} else {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	if e.Name == "" && statusCode == http.StatusTooManyRequests {
+		e.Name = ErrTooManyRequests.Name
+	}
+	return e
+}
+
+func (e *APIError) Error() string {
+	if e.Name == "" {
+		return "HTTP " + strconv.Itoa(e.StatusCode) + " (" + e.Message + ")"
+	}
+	return e.Name + " (" + e.Message + ")"
+}
+
+// errors.Is 비교
+//  target 이 APIError 면 채워진 Name, StatusCode 가 모두 같을 때, ErrServerError 면 5xx 일 때 일치합니다.
+func (e *APIError) Is(target error) bool {
+	if target == ErrServerError {
+		return e.StatusCode >= 500
+	}
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	if t == ErrTooManyRequests {
+		return e.StatusCode == http.StatusTooManyRequests || e.Name == t.Name
+	}
+	return (t.Name == "" || t.Name == e.Name) && (t.StatusCode == 0 || t.StatusCode == e.StatusCode)
+}
+
+// 응답 디코딩 오류
+//  업비트 응답을 Block 으로 바꾸지 못하면 Common.Error 로 반환됩니다.
+type DecodeError struct {
+	// 응답 본문
+	Body []byte
+	// json 오류
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return "Failed to decode response! (" + e.Err.Error() + ")"
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// 파라미터 검사 오류
+//  요청을 보내기 전에 잘못된 파라미터를 발견하면 panic 대신 Common.Error 로 반환됩니다.
+//	errors.As 로 꺼내 Field 를 확인할 수 있습니다.
+type ValidationError struct {
+	// 잘못된 필드 이름 (ex. Count, Uuid)
+	Field string
+	// 오류 내용
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// 파라미터 검사 오류 만들기
+func newValidationError(field string, message string) *ValidationError {
+	return &ValidationError{Field: field, Message: message}
+}
@@ -0,0 +1,59 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"time"
+)
+
+// 시세 조회(Quotation API) 인터페이스
+//  *Upbit 이 구현합니다.
+type Quotation interface {
+	MarketAllContext(ctx context.Context, isDetails bool) UpbitMarketAll
+	CandlesContext(ctx context.Context, market string, interval Interval, to time.Time, count int) UpbitCandles
+	CandlesSecondsContext(ctx context.Context, market string, to time.Time, count int) UpbitCandlesSeconds
+	CandlesMinutesContext(ctx context.Context, unit int, market string, to time.Time, count int) UpbitCandlesMinutes
+	CandlesDaysContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesDays
+	CandlesWeeksContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesWeeks
+	CandlesMonthsContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesMonths
+	TradesTicksContext(ctx context.Context, market string, to string, count int, cursor string, daysAgo int) UpbitTradesTicks
+	TickerContext(ctx context.Context, markets []string) UpbitTicker
+	OrderbookContext(ctx context.Context, markets []string) UpbitOrderbook
+}
+
+// 거래(Exchange API) 인터페이스
+//  *Upbit 과 paper.Exchange 가 구현합니다.
+type Exchange interface {
+	AccountsContext(ctx context.Context) UpbitAccounts
+	OrdersChanceContext(ctx context.Context, bidCurrencyTicker string, AskCurrencyTicker string) UpbitOrdersChance
+	OrderContext(ctx context.Context, opt OrderOption) UpbitOrder
+	OrdersContext(ctx context.Context, opt OrdersOption) UpbitOrders
+	PlaceOrderContext(ctx context.Context, opt PlaceOrderOption) UpbitOrder
+	CancelOrderContext(ctx context.Context, opt OrderOption) UpbitOrder
+}
+
+// 시세 조회 + 거래 인터페이스
+//  *Upbit 을 직접 쓰는 대신 이 인터페이스에 의존하면 가짜, 모의 거래, 기록 재생 구현으로 바꿔 끼울 수 있습니다.
+type API interface {
+	Quotation
+	Exchange
+}
+
+var _ API = (*Upbit)(nil)
+
+// 시세 조회와 거래 구현 합치기
+//  ex. 실제 시세(*Upbit) + 모의 거래(paper.Exchange)
+func Combine(quotation Quotation, exchange Exchange) API {
+	return &combined{Quotation: quotation, Exchange: exchange}
+}
+
+// 시세 조회와 거래 구현을 합친 API
+type combined struct {
+	Quotation
+	Exchange
+}
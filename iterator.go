@@ -0,0 +1,176 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// 주문 리스트 반복자
+//  페이지를 넘기며 주문 리스트 전체를 조회합니다.
+//	for it.Next() { it.Order() } 형태로 사용하고, 끝난 뒤 it.Common().Error 를 확인하세요.
+type OrdersIterator struct {
+	ctx      context.Context
+	upbit    *Upbit
+	opt      OrdersOption
+	from, to time.Time
+	buf      []UpbitOrderBlock
+	cur      UpbitOrderBlock
+	common   UpbitCommonBlock
+	done     bool
+}
+
+// 주문 리스트 반복자 만들기
+//  from, to 는 주문 생성 시각 범위 [from, to) 이며, zero value 이면 제한하지 않습니다.
+//	정렬 방식(OrderBy)에 맞춰 범위를 벗어나면 더 이상 페이지를 조회하지 않습니다.
+// Params:
+//	opt = 주문 리스트 조회 옵션 (Page 는 시작 페이지)
+//	from = 주문 생성 시각 시작 (inclusive)
+//	to = 주문 생성 시각 끝 (exclusive)
+func (o *Upbit) OrdersIterator(opt OrdersOption, from time.Time, to time.Time) *OrdersIterator {
+	return o.OrdersIteratorContext(context.Background(), opt, from, to)
+}
+
+// OrdersIterator 의 context 버전
+//  ctx 는 페이지를 조회할 때마다 사용됩니다.
+func (o *Upbit) OrdersIteratorContext(ctx context.Context, opt OrdersOption, from time.Time, to time.Time) *OrdersIterator {
+	if opt.Page <= 0 {
+		opt.Page = 1
+	}
+	if opt.Limit <= 0 {
+		opt.Limit = 100
+	}
+	if opt.OrderBy == "" {
+		opt.OrderBy = "desc"
+	}
+	return &OrdersIterator{ctx: ctx, upbit: o, opt: opt, from: from, to: to}
+}
+
+// 다음 주문으로 이동
+//  더 이상 주문이 없거나 오류가 나면 false 를 반환합니다.
+func (it *OrdersIterator) Next() bool {
+	for {
+		for len(it.buf) > 0 {
+			block := it.buf[0]
+			it.buf = it.buf[1:]
+
+			createdAt := block.CreatedAt
+			if createdAt.IsZero() {
+				it.cur = block
+				return true
+			}
+			if !it.from.IsZero() && createdAt.Before(it.from) {
+				if it.opt.OrderBy == "desc" {
+					it.done = true
+					it.buf = nil
+					return false
+				}
+				continue
+			}
+			if !it.to.IsZero() && !createdAt.Before(it.to) {
+				if it.opt.OrderBy == "asc" {
+					it.done = true
+					it.buf = nil
+					return false
+				}
+				continue
+			}
+			it.cur = block
+			return true
+		}
+		if it.done {
+			return false
+		}
+
+		res := it.upbit.OrdersContext(it.ctx, it.opt)
+		it.common = res.Common
+		if res.Common.Error != nil {
+			it.done = true
+			return false
+		}
+		if len(res.Response) < it.opt.Limit {
+			it.done = true
+		}
+		it.buf = res.Response
+		it.opt.Page++
+	}
+}
+
+// 현재 주문
+func (it *OrdersIterator) Order() UpbitOrderBlock {
+	return it.cur
+}
+
+// 마지막 페이지 조회 결과
+func (it *OrdersIterator) Common() UpbitCommonBlock {
+	return it.common
+}
+
+// 최근 체결 내역 반복자
+//  sequential_id 커서로 페이지를 넘기며 하루치 체결 내역 전체를 조회합니다.
+//	최신 체결부터 과거 순서로 반환됩니다.
+type TradesTicksIterator struct {
+	ctx     context.Context
+	upbit   *Upbit
+	market  string
+	daysAgo int
+	cursor  string
+	buf     []UpbitTradesTicksBlock
+	cur     UpbitTradesTicksBlock
+	common  UpbitCommonBlock
+	done    bool
+}
+
+// 최근 체결 내역 반복자 만들기
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+//	daysAgo = 최근 영업일 기준 며칠 전 데이터 (1 ~ 7). 0 이면 당일
+func (o *Upbit) TradesTicksIterator(market string, daysAgo int) *TradesTicksIterator {
+	return o.TradesTicksIteratorContext(context.Background(), market, daysAgo)
+}
+
+// TradesTicksIterator 의 context 버전
+//  ctx 는 페이지를 조회할 때마다 사용됩니다.
+func (o *Upbit) TradesTicksIteratorContext(ctx context.Context, market string, daysAgo int) *TradesTicksIterator {
+	return &TradesTicksIterator{ctx: ctx, upbit: o, market: market, daysAgo: daysAgo}
+}
+
+// 다음 체결로 이동
+//  더 이상 체결이 없거나 오류가 나면 false 를 반환합니다.
+func (it *TradesTicksIterator) Next() bool {
+	if len(it.buf) <= 0 {
+		if it.done {
+			return false
+		}
+		res := it.upbit.TradesTicksContext(it.ctx, it.market, "", 500, it.cursor, it.daysAgo)
+		it.common = res.Common
+		if res.Common.Error != nil || len(res.Response) <= 0 {
+			it.done = true
+			return false
+		}
+		if len(res.Response) < 500 {
+			it.done = true
+		}
+		it.buf = res.Response
+		it.cursor = strconv.FormatInt(res.Response[len(res.Response)-1].SequentialId, 10)
+	}
+	it.cur = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// 현재 체결
+func (it *TradesTicksIterator) Tick() UpbitTradesTicksBlock {
+	return it.cur
+}
+
+// 마지막 페이지 조회 결과
+func (it *TradesTicksIterator) Common() UpbitCommonBlock {
+	return it.common
+}
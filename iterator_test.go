@@ -0,0 +1,52 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"testing"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// OrdersIterator 테스트
+//  최근 1년간 종료된 주문을 끝까지 조회
+func TestUpbitOrdersIterator(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	to := testNow
+	from := to.AddDate(-1, 0, 0)
+	it := upbit.OrdersIterator(OrdersOption{Market: "KRW-BTC", States: []OrderState{ORDER_STATE_DONE, ORDER_STATE_CANCEL}}, from, to)
+	for it.Next() {
+		createdAt := it.Order().CreatedAt
+		if createdAt.Before(from) || !createdAt.Before(to) {
+			t.Errorf("TestUpbitOrdersIterator | Uuid:[%s], CreatedAt:[%s] is out of range", it.Order().Uuid, it.Order().CreatedAt)
+		}
+	}
+	if it.Common().Error != nil {
+		t.Errorf("TestUpbitOrdersIterator | Status:[%d], OrdersIteratorErr:[%s]", it.Common().StatusCode, it.Common().Error)
+	}
+}
+
+// TradesTicksIterator 테스트
+//  어제 하루치 체결 내역을 끝까지 조회
+func TestUpbitTradesTicksIterator(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	it := upbit.TradesTicksIterator("KRW-BTC", 1)
+	var last int64
+	for it.Next() {
+		if last != 0 && it.Tick().SequentialId >= last {
+			t.Errorf("TestUpbitTradesTicksIterator | SequentialId:[%d] is not older than [%d]", it.Tick().SequentialId, last)
+		}
+		last = it.Tick().SequentialId
+	}
+	if it.Common().Error != nil {
+		t.Errorf("TestUpbitTradesTicksIterator | Status:[%d], tradesTicksIteratorErr:[%s]", it.Common().StatusCode, it.Common().Error)
+	}
+}
@@ -0,0 +1,347 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// API 호출 정보
+type Call struct {
+	// 호출한 메서드 이름 (Context 접미사 제외, ex. Ticker)
+	Method string
+	// 호출 인자 (ctx 제외)
+	Args []interface{}
+
+	invoke func(ctx context.Context) (interface{}, UpbitCommonBlock)
+}
+
+// API 호출 처리기
+//  결과(ex. UpbitTicker)와 그 Common 을 반환합니다.
+type Handler func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock)
+
+// 미들웨어
+//  next 를 감싸 로그, 지표, 캐시 같은 기능을 덧붙입니다.
+type Middleware func(next Handler) Handler
+
+// API 를 미들웨어로 감싸기
+//  mws 는 앞의 것이 바깥쪽입니다. 반환된 API 의 모든 메서드 호출이 미들웨어를 거칩니다.
+// Params:
+//	api = 감쌀 구현 (ex. *Upbit, Combine(upbit, paperExchange))
+//	mws = 미들웨어 목록
+func Wrap(api API, mws ...Middleware) API {
+	handler := Handler(func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock) {
+		return call.invoke(ctx)
+	})
+	for i := len(mws) - 1; i >= 0; i-- {
+		handler = mws[i](handler)
+	}
+	return &wrapped{api: api, handler: handler}
+}
+
+// 호출 시간과 결과를 기록하는 미들웨어
+//  logf 로 "Method args status=... err=... elapsed=..." 한 줄을 남깁니다. (ex. log.Printf)
+func LoggingMiddleware(logf func(format string, v ...interface{})) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock) {
+			start := time.Now()
+			res, common := next(ctx, call)
+			logf("%s %v status=%d err=%v elapsed=%s", call.Method, call.Args, common.StatusCode, common.Error, time.Since(start))
+			return res, common
+		}
+	}
+}
+
+// 호출 지표를 남기는 미들웨어
+//  호출이 끝날 때마다 observe 로 메서드 이름, 결과, 걸린 시간을 넘깁니다.
+func MetricsMiddleware(observe func(method string, common UpbitCommonBlock, elapsed time.Duration)) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock) {
+			start := time.Now()
+			res, common := next(ctx, call)
+			observe(call.Method, common, time.Since(start))
+			return res, common
+		}
+	}
+}
+
+// 캐시 항목
+type cacheEntry struct {
+	res     interface{}
+	common  UpbitCommonBlock
+	expires time.Time
+}
+
+// 결과를 캐시하는 미들웨어
+//  같은 메서드와 인자로 성공한 결과를 ttl 동안 재사용합니다. 만료된 항목은 지우며, 호출마다 결과의 복사본을 반환합니다.
+//	methods 를 비우면 시세 조회 메서드(MarketAll, Candles*, TradesTicks, Ticker, Orderbook)만 캐시합니다.
+// Params:
+//	ttl = 캐시 유지 시간
+//	methods = 캐시할 메서드 이름 목록 (ex. MarketAll)
+func CachingMiddleware(ttl time.Duration, methods ...string) Middleware {
+	if len(methods) <= 0 {
+		methods = []string{"MarketAll", "Candles", "CandlesSeconds", "CandlesMinutes", "CandlesDays", "CandlesWeeks", "CandlesMonths", "TradesTicks", "Ticker", "Orderbook"}
+	}
+	cacheable := map[string]bool{}
+	for _, method := range methods {
+		cacheable[method] = true
+	}
+
+	var mu sync.Mutex
+	cache := map[string]cacheEntry{}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock) {
+			if !cacheable[call.Method] {
+				return next(ctx, call)
+			}
+			key := call.Method + fmt.Sprintf("%#v", call.Args)
+			mu.Lock()
+			entry, ok := cache[key]
+			if ok && !time.Now().Before(entry.expires) {
+				delete(cache, key)
+				ok = false
+			}
+			mu.Unlock()
+			if ok {
+				return cloneResult(entry.res), entry.common
+			}
+
+			res, common := next(ctx, call)
+			if common.Error == nil && res != nil {
+				now := time.Now()
+				mu.Lock()
+				// 만료된 항목 정리 (to 커서마다 키가 달라지는 백필 등)
+				for k, v := range cache {
+					if !now.Before(v.expires) {
+						delete(cache, k)
+					}
+				}
+				cache[key] = cacheEntry{res: cloneResult(res), common: common, expires: now.Add(ttl)}
+				mu.Unlock()
+			}
+			return res, common
+		}
+	}
+}
+
+// 결과 복사본
+//  캐시한 결과의 슬라이스(Response 등)를 호출한 쪽이 고쳐도 다른 호출에 보이지 않도록 슬라이스를 새로 만듭니다.
+func cloneResult(res interface{}) interface{} {
+	if res == nil {
+		return nil
+	}
+	return cloneValue(reflect.ValueOf(res)).Interface()
+}
+
+// 슬라이스, 배열, 구조체의 공개 필드를 따라가며 복사
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array, reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		if v.Kind() == reflect.Array {
+			for i := 0; i < v.Len(); i++ {
+				c.Index(i).Set(cloneValue(v.Index(i)))
+			}
+			return c
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// 미들웨어로 감싼 API
+type wrapped struct {
+	api     API
+	handler Handler
+}
+
+// 처리기를 거쳐 호출
+//  미들웨어가 돌려준 Common 을 그대로 반환합니다.
+//	미들웨어가 결과 없이(nil) 끝내면 각 메서드는 Common 만 담은 영값 결과를 반환합니다.
+func (w *wrapped) call(ctx context.Context, method string, args []interface{}, invoke func(ctx context.Context) (interface{}, UpbitCommonBlock)) (interface{}, UpbitCommonBlock) {
+	return w.handler(ctx, Call{Method: method, Args: args, invoke: invoke})
+}
+
+func (w *wrapped) MarketAllContext(ctx context.Context, isDetails bool) UpbitMarketAll {
+	raw, common := w.call(ctx, "MarketAll", []interface{}{isDetails}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.MarketAllContext(ctx, isDetails)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitMarketAll)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesContext(ctx context.Context, market string, interval Interval, to time.Time, count int) UpbitCandles {
+	raw, common := w.call(ctx, "Candles", []interface{}{market, interval, to, count}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesContext(ctx, market, interval, to, count)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandles)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesSecondsContext(ctx context.Context, market string, to time.Time, count int) UpbitCandlesSeconds {
+	raw, common := w.call(ctx, "CandlesSeconds", []interface{}{market, to, count}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesSecondsContext(ctx, market, to, count)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandlesSeconds)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesMinutesContext(ctx context.Context, unit int, market string, to time.Time, count int) UpbitCandlesMinutes {
+	raw, common := w.call(ctx, "CandlesMinutes", []interface{}{unit, market, to, count}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesMinutesContext(ctx, unit, market, to, count)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandlesMinutes)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesDaysContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesDays {
+	raw, common := w.call(ctx, "CandlesDays", []interface{}{market, to, count, convertingPriceUnit}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesDaysContext(ctx, market, to, count, convertingPriceUnit)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandlesDays)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesWeeksContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesWeeks {
+	raw, common := w.call(ctx, "CandlesWeeks", []interface{}{market, to, count, convertingPriceUnit}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesWeeksContext(ctx, market, to, count, convertingPriceUnit)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandlesWeeks)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CandlesMonthsContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesMonths {
+	raw, common := w.call(ctx, "CandlesMonths", []interface{}{market, to, count, convertingPriceUnit}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CandlesMonthsContext(ctx, market, to, count, convertingPriceUnit)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitCandlesMonths)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) TradesTicksContext(ctx context.Context, market string, to string, count int, cursor string, daysAgo int) UpbitTradesTicks {
+	raw, common := w.call(ctx, "TradesTicks", []interface{}{market, to, count, cursor, daysAgo}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.TradesTicksContext(ctx, market, to, count, cursor, daysAgo)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitTradesTicks)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) TickerContext(ctx context.Context, markets []string) UpbitTicker {
+	raw, common := w.call(ctx, "Ticker", []interface{}{markets}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.TickerContext(ctx, markets)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitTicker)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) OrderbookContext(ctx context.Context, markets []string) UpbitOrderbook {
+	raw, common := w.call(ctx, "Orderbook", []interface{}{markets}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.OrderbookContext(ctx, markets)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrderbook)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) AccountsContext(ctx context.Context) UpbitAccounts {
+	raw, common := w.call(ctx, "Accounts", nil, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.AccountsContext(ctx)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitAccounts)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) OrdersChanceContext(ctx context.Context, bidCurrencyTicker string, AskCurrencyTicker string) UpbitOrdersChance {
+	raw, common := w.call(ctx, "OrdersChance", []interface{}{bidCurrencyTicker, AskCurrencyTicker}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.OrdersChanceContext(ctx, bidCurrencyTicker, AskCurrencyTicker)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrdersChance)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) OrderContext(ctx context.Context, opt OrderOption) UpbitOrder {
+	raw, common := w.call(ctx, "Order", []interface{}{opt}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.OrderContext(ctx, opt)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrder)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) OrdersContext(ctx context.Context, opt OrdersOption) UpbitOrders {
+	raw, common := w.call(ctx, "Orders", []interface{}{opt}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.OrdersContext(ctx, opt)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrders)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) PlaceOrderContext(ctx context.Context, opt PlaceOrderOption) UpbitOrder {
+	raw, common := w.call(ctx, "PlaceOrder", []interface{}{opt}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.PlaceOrderContext(ctx, opt)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrder)
+	res.Common = common
+	return res
+}
+
+func (w *wrapped) CancelOrderContext(ctx context.Context, opt OrderOption) UpbitOrder {
+	raw, common := w.call(ctx, "CancelOrder", []interface{}{opt}, func(ctx context.Context) (interface{}, UpbitCommonBlock) {
+		res := w.api.CancelOrderContext(ctx, opt)
+		return res, res.Common
+	})
+	res, _ := raw.(UpbitOrder)
+	res.Common = common
+	return res
+}
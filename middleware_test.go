@@ -0,0 +1,102 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+	"github.com/davidjung-kr/yauga/paper"
+)
+
+// Wrap 테스트
+//  캐시된 시세는 다시 요청하지 않고, 로그와 지표는 모든 호출에 남아야 함
+func TestUpbitWrap(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	var logs []string
+	observed := map[string]int{}
+	api := Wrap(server.NewUpbit(),
+		LoggingMiddleware(func(format string, v ...interface{}) { logs = append(logs, fmt.Sprintf(format, v...)) }),
+		MetricsMiddleware(func(method string, common UpbitCommonBlock, elapsed time.Duration) { observed[method]++ }),
+		CachingMiddleware(time.Minute),
+	)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		x := api.MarketAllContext(ctx, false)
+		if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 2 || x.Response[0].Market != "KRW-BTC" {
+			t.Errorf("TestUpbitWrap | Status:[%d], Market:[%s], marketAllErr:[%s]", x.Common.StatusCode, x.Response[0].Market, x.Common.Error)
+		}
+		// 캐시한 결과를 고쳐도 다음 호출에 보이지 않아야 함
+		x.Response[0].Market = "changed"
+	}
+	y := api.AccountsContext(ctx)
+	if y.Common.StatusCode != 200 || y.Common.Error != nil {
+		t.Errorf("TestUpbitWrap | Status:[%d], accountsErr:[%s]", y.Common.StatusCode, y.Common.Error)
+	}
+
+	requests := 0
+	for _, v := range server.Requests() {
+		if strings.HasPrefix(v, "GET /v1/market/all") {
+			requests++
+		}
+	}
+	if requests != 1 || observed["MarketAll"] != 3 || observed["Accounts"] != 1 || len(logs) != 4 {
+		t.Errorf("TestUpbitWrap | Requests:[%d], Observed:[%v], Logs:[%d]", requests, observed, len(logs))
+	}
+}
+
+// 결과 없이 끝내는 미들웨어 테스트
+//  미들웨어가 돌려준 Common 이 호출한 쪽에 전달되고, 결과가 nil 이어도 panic 하지 않아야 함
+func TestUpbitWrapShortCircuit(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	blocked := errors.New("blocked")
+	api := Wrap(server.NewUpbit(), func(next Handler) Handler {
+		return func(ctx context.Context, call Call) (interface{}, UpbitCommonBlock) {
+			if call.Method == "PlaceOrder" {
+				return nil, UpbitCommonBlock{Error: blocked}
+			}
+			res, common := next(ctx, call)
+			common.Error = blocked
+			return res, common
+		}
+	})
+	x := api.PlaceOrderContext(context.Background(), PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_PRICE, Price: "10000"})
+	if !errors.Is(x.Common.Error, blocked) || x.Response.Uuid != "" {
+		t.Errorf("TestUpbitWrapShortCircuit | Uuid:[%s], placeOrderErr:[%v]", x.Response.Uuid, x.Common.Error)
+	}
+	y := api.MarketAllContext(context.Background(), false)
+	if !errors.Is(y.Common.Error, blocked) || y.Common.StatusCode != 200 || len(y.Response) != 2 {
+		t.Errorf("TestUpbitWrapShortCircuit | Status:[%d], marketAllErr:[%v]", y.Common.StatusCode, y.Common.Error)
+	}
+}
+
+// Combine 테스트
+//  가짜 서버 시세 + 모의 거래를 하나의 API 로 쓸 수 있어야 함
+func TestUpbitCombine(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	ex := paper.NewExchange(map[string]Decimal{"KRW": MustDecimal("1000000")})
+	api := Combine(server.NewUpbit(), ex)
+	ctx := context.Background()
+	for _, book := range api.OrderbookContext(ctx, []string{"KRW-BTC"}).Response {
+		ex.UpdateOrderbook(book)
+	}
+	x := api.PlaceOrderContext(ctx, PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_PRICE, Price: "10000"})
+	if x.Common.Error != nil || x.Response.State != "done" {
+		t.Errorf("TestUpbitCombine | State:[%s], placeOrderErr:[%s]", x.Response.State, x.Common.Error)
+	}
+}
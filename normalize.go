@@ -0,0 +1,183 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// 주문 수량 소수점 자릿수
+	VOLUME_PRECISION = 8
+)
+
+// 주문 가격, 수량 정규화기
+//  주문 가능 정보(orders/chance)의 제약으로 가격을 호가 단위에 맞추고,
+//	최소/최대 주문 금액, 수수료, 현재 잔고로 살 수 있는 최대 수량을 계산합니다.
+type Normalizer struct {
+	// 마켓 코드 (ex. KRW-BTC)
+	Market string
+	// 주문 가능 정보
+	Chance UpbitOrdersChanceBlock
+	// 호가 단위 계산 (nil 이면 TickSizeDecimal)
+	//  업비트 호가 정책이 내장된 표와 다를 때 덮어씁니다.
+	TickSizeFunc func(market string, price Decimal) Decimal
+}
+
+// Initialization
+// Params:
+//	chance = 주문 가능 정보 (ex. upbit.OrdersChance("KRW", "BTC").Response)
+func NewNormalizer(chance UpbitOrdersChanceBlock) *Normalizer {
+	return &Normalizer{Market: chance.Market.Id, Chance: chance}
+}
+
+// 가격의 호가 단위
+func (n *Normalizer) TickSize(price Decimal) Decimal {
+	if n.TickSizeFunc != nil {
+		return n.TickSizeFunc(n.Market, price)
+	}
+	return TickSizeDecimal(n.Market, price)
+}
+
+// 가격을 호가 단위에 맞추기
+//  ROUND_DOWN 은 낮은 가격, ROUND_UP 은 높은 가격, ROUND_HALF_UP 은 가까운 가격으로 맞춥니다.
+// Params:
+//	price = 주문 가격
+//	mode = 반올림 방식
+func (n *Normalizer) RoundPrice(price Decimal, mode RoundingMode) Decimal {
+	return price.RoundStep(n.TickSize(price), mode)
+}
+
+// 수량을 주문 가능한 자릿수로 버림
+func (n *Normalizer) RoundVolume(volume Decimal) Decimal {
+	return volume.Round(VOLUME_PRECISION, ROUND_DOWN)
+}
+
+// 주문 금액을 기준 화폐 자릿수로 버림
+//  원화는 1원, 그 외는 소수점 8자리 단위입니다.
+func (n *Normalizer) RoundFunds(funds Decimal) Decimal {
+	if strings.HasPrefix(n.Market, "KRW-") {
+		return funds.Round(0, ROUND_DOWN)
+	}
+	return funds.Round(VOLUME_PRECISION, ROUND_DOWN)
+}
+
+// 수수료 비율
+func (n *Normalizer) FeeRate(side OrderSide) Decimal {
+	if side == ORDER_SIDE_ASK {
+		return n.Chance.AskFee
+	}
+	return n.Chance.BidFee
+}
+
+// 주문 금액에 대한 수수료
+func (n *Normalizer) Fee(side OrderSide, total Decimal) Decimal {
+	return total.Mul(n.FeeRate(side))
+}
+
+// 최소 주문 금액 (제약이 없으면 0)
+func (n *Normalizer) MinTotal(side OrderSide) Decimal {
+	if side == ORDER_SIDE_ASK {
+		return n.Chance.Market.Ask.MinTotal
+	}
+	return n.Chance.Market.Bid.MinTotal
+}
+
+// 최대 주문 금액 (제약이 없으면 0)
+func (n *Normalizer) MaxTotal() Decimal {
+	return n.Chance.Market.MaxTotal
+}
+
+// 주문 금액이 최소/최대 주문 금액 안에 있는지 확인
+//  벗어나면 Field 가 "Total" 인 *ValidationError 를 반환합니다.
+// Params:
+//	side = 주문 종류
+//	total = 주문 금액 (가격 × 수량)
+func (n *Normalizer) CheckTotal(side OrderSide, total Decimal) error {
+	if min := n.MinTotal(side); min.Sign() > 0 && total.LessThan(min) {
+		return newValidationError("Total", fmt.Sprintf("Total must be at least %s!", min))
+	}
+	if max := n.MaxTotal(); max.Sign() > 0 && total.GreaterThan(max) {
+		return newValidationError("Total", fmt.Sprintf("Total must be at most %s!", max))
+	}
+	return nil
+}
+
+// 현재 잔고로 주문할 수 있는 최대 수량
+//  매수는 수수료를 더한 금액이 주문 가능 금액을 넘지 않는 수량, 매도는 주문 가능 수량입니다.
+// Params:
+//	side = 주문 종류
+//	price = 주문 가격 (매수만 사용)
+func (n *Normalizer) MaxVolume(side OrderSide, price Decimal) Decimal {
+	if side == ORDER_SIDE_ASK {
+		return n.RoundVolume(n.Chance.AskAccount.Balance)
+	}
+	if price.Sign() <= 0 {
+		return NewDecimalFromInt(0)
+	}
+	cost := price.Mul(NewDecimalFromInt(1).Add(n.FeeRate(side)))
+	volume, err := n.Chance.BidAccount.Balance.Div(cost, VOLUME_PRECISION, ROUND_DOWN)
+	if err != nil || cost.Sign() < 0 {
+		return NewDecimalFromInt(0)
+	}
+	return volume
+}
+
+// 현재 잔고로 주문할 수 있는 최대 시장가 매수 금액
+//  수수료를 더해도 주문 가능 금액을 넘지 않는 금액입니다. 수수료율이 잘못돼(-1 이하) 계산할 수 없으면 0 입니다.
+func (n *Normalizer) MaxFunds() Decimal {
+	rate := NewDecimalFromInt(1).Add(n.FeeRate(ORDER_SIDE_BID))
+	funds, err := n.Chance.BidAccount.Balance.Div(rate, VOLUME_PRECISION, ROUND_DOWN)
+	if err != nil || rate.Sign() < 0 {
+		return NewDecimalFromInt(0)
+	}
+	return n.RoundFunds(funds)
+}
+
+// 주문 정규화
+//  지정가는 가격을 호가 단위로 맞추고, 수량과 시장가 매수 금액은 주문 가능한 자릿수로 버린 뒤 최소/최대 주문 금액을 확인합니다.
+//	시장가 매도(market)와 최유리 매도(best)는 가격을 모르므로 주문 금액을 확인하지 않습니다.
+// Params:
+//	opt = 주문 옵션 (Price, Volume 은 십진수 문자열)
+//	mode = 가격 반올림 방식
+func (n *Normalizer) Normalize(opt PlaceOrderOption, mode RoundingMode) (PlaceOrderOption, error) {
+	if n.Market != "" && opt.Market != "" && opt.Market != n.Market {
+		return opt, newValidationError("Market", fmt.Sprintf("Market must be %s!", n.Market))
+	}
+	var price, volume Decimal
+	var err error
+	if opt.Price != "" {
+		if price, err = ParseDecimal(opt.Price); err != nil || price.Sign() <= 0 {
+			return opt, newValidationError("Price", "Price must be a positive number!")
+		}
+	}
+	if opt.Volume != "" {
+		if volume, err = ParseDecimal(opt.Volume); err != nil || volume.Sign() <= 0 {
+			return opt, newValidationError("Volume", "Volume must be a positive number!")
+		}
+		if volume = n.RoundVolume(volume); volume.Sign() <= 0 {
+			return opt, newValidationError("Volume", "Volume is too small!")
+		}
+		opt.Volume = volume.String()
+	}
+
+	switch {
+	case opt.OrdType == ORDER_TYPE_LIMIT && opt.Price != "":
+		price = n.RoundPrice(price, mode)
+		opt.Price = price.String()
+		if opt.Volume != "" {
+			err = n.CheckTotal(opt.Side, price.Mul(volume))
+		}
+	case opt.Price != "":
+		// 시장가 매수, 최유리 매수의 Price 는 주문 금액
+		price = n.RoundFunds(price)
+		opt.Price = price.String()
+		err = n.CheckTotal(opt.Side, price)
+	}
+	return opt, err
+}
@@ -0,0 +1,104 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"errors"
+	"testing"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// 테스트용 주문 가능 정보 (KRW-BTC, 수수료 0.05%, 최소 5000원)
+func testChance() UpbitOrdersChanceBlock {
+	return UpbitOrdersChanceBlock{
+		BidFee: MustDecimal("0.0005"),
+		AskFee: MustDecimal("0.0005"),
+		Market: MarketBlock{
+			Id:         "KRW-BTC",
+			OrderTypes: []string{"limit", "price", "market"},
+			OrderSides: []string{"ask", "bid"},
+			Bid:        BidAskBlock{Currency: "KRW", MinTotal: MustDecimal("5000")},
+			Ask:        BidAskBlock{Currency: "KRW", MinTotal: MustDecimal("5000")},
+			MaxTotal:   MustDecimal("1000000000.0"),
+			State:      "active",
+		},
+		BidAccount: BidAskAccountBlock{Currency: "KRW", Balance: MustDecimal("1000000")},
+		AskAccount: BidAskAccountBlock{Currency: "BTC", Balance: MustDecimal("0.0123456789")},
+	}
+}
+
+// Normalizer 테스트
+//  가격을 호가 단위로 맞추고, 최소/최대 주문 금액과 수수료를 포함한 최대 수량을 계산해야 함
+func TestUpbitNormalizer(t *testing.T) {
+	n := NewNormalizer(testChance())
+	prices := []struct {
+		price string
+		mode  RoundingMode
+		want  string
+	}{
+		{"50001234", ROUND_DOWN, "50001000"},
+		{"50001234", ROUND_UP, "50002000"},
+		{"50001500", ROUND_HALF_UP, "50002000"},
+		{"1999800", ROUND_UP, "2000000"},
+		{"512.34", ROUND_HALF_UP, "512"},
+		{"51.234", ROUND_HALF_UP, "51.2"},
+		{"0.123456", ROUND_DOWN, "0.123"},
+	}
+	for _, c := range prices {
+		if x := n.RoundPrice(MustDecimal(c.price), c.mode); x.String() != c.want {
+			t.Errorf("TestUpbitNormalizer | Price:[%s], RoundPrice:[%s]", c.price, x)
+		}
+	}
+
+	// 호가 단위 덮어쓰기
+	custom := NewNormalizer(testChance())
+	custom.TickSizeFunc = func(market string, price Decimal) Decimal { return MustDecimal("5000") }
+	if x := custom.RoundPrice(MustDecimal("50001234"), ROUND_DOWN); x.String() != "50000000" {
+		t.Errorf("TestUpbitNormalizer | TickSizeFunc RoundPrice:[%s]", x)
+	}
+
+	if x := n.MaxVolume(ORDER_SIDE_BID, MustDecimal("50000000")); x.String() != "0.01999000" {
+		t.Errorf("TestUpbitNormalizer | Bid MaxVolume:[%s]", x)
+	}
+	if x := n.MaxVolume(ORDER_SIDE_ASK, Decimal{}); x.String() != "0.01234567" {
+		t.Errorf("TestUpbitNormalizer | Ask MaxVolume:[%s]", x)
+	}
+	if x := n.MaxFunds(); x.String() != "999500" {
+		t.Errorf("TestUpbitNormalizer | MaxFunds:[%s]", x)
+	}
+	broken := testChance()
+	broken.BidFee = MustDecimal("-1")
+	if x := NewNormalizer(broken); !x.MaxVolume(ORDER_SIDE_BID, MustDecimal("50000000")).IsZero() || !x.MaxFunds().IsZero() {
+		t.Errorf("TestUpbitNormalizer | MaxVolume:[%s], MaxFunds:[%s]", x.MaxVolume(ORDER_SIDE_BID, MustDecimal("50000000")), x.MaxFunds())
+	}
+	if x := n.Fee(ORDER_SIDE_BID, MustDecimal("10000")); !x.Equal(MustDecimal("5")) {
+		t.Errorf("TestUpbitNormalizer | Fee:[%s]", x)
+	}
+
+	opt, err := n.Normalize(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001234", Volume: "0.000123456789"}, ROUND_DOWN)
+	if err != nil || opt.Price != "50001000" || opt.Volume != "0.00012345" {
+		t.Errorf("TestUpbitNormalizer | Price:[%s], Volume:[%s], normalizeErr:[%s]", opt.Price, opt.Volume, err)
+	}
+	var validationErr *ValidationError
+	cases := []struct {
+		opt   PlaceOrderOption
+		field string
+	}{
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50000000", Volume: "0.00009"}, "Total"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50000000", Volume: "21"}, "Total"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_PRICE, Price: "4999.9"}, "Total"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_ASK, OrdType: ORDER_TYPE_MARKET, Volume: "0.000000001"}, "Volume"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "abc", Volume: "1"}, "Price"},
+		{PlaceOrderOption{Market: "KRW-ETH", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "1000", Volume: "1"}, "Market"},
+	}
+	for _, c := range cases {
+		if _, err := n.Normalize(c.opt, ROUND_DOWN); !errors.As(err, &validationErr) || validationErr.Field != c.field {
+			t.Errorf("TestUpbitNormalizer | Field:[%s], normalizeErr:[%v]", c.field, err)
+		}
+	}
+}
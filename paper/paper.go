@@ -0,0 +1,876 @@
+package paper
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+	"github.com/google/uuid"
+)
+
+const (
+	// 원화 마켓 수수료율
+	FEE_RATE_KRW = 0.0005
+	// BTC 마켓 수수료율
+	FEE_RATE_BTC = 0.0025
+	// USDT 마켓 수수료율
+	FEE_RATE_USDT = 0.0025
+	// 원화 마켓 최소 주문 금액
+	MIN_TOTAL_KRW = 5000
+	// BTC 마켓 최소 주문 금액
+	MIN_TOTAL_BTC = 0.00005
+	// USDT 마켓 최소 주문 금액
+	MIN_TOTAL_USDT = 0.5
+	// 원화 마켓 최대 주문 금액
+	MAX_TOTAL_KRW = 1000000000
+)
+
+var (
+	zero = yauga.NewDecimalFromInt(0)
+	one  = yauga.NewDecimalFromInt(1)
+	// 최소 주문 수량 단위
+	minVolume = yauga.MustDecimal("0.00000001")
+)
+
+// 모의 거래소
+//  업비트와 같은 주문 API(accounts, orders/chance, order, orders, 주문, 주문 취소)를 메모리 위의 잔고로 흉내냅니다.
+//	체결은 UpdateTicker, UpdateOrderbook 으로 넣어주는 실시간 혹은 재생한 시세로 일어납니다.
+//	수수료율과 호가 단위는 업비트와 같습니다. 잔고와 주문 금액은 yauga.Decimal 로 오차 없이 계산합니다.
+type Exchange struct {
+	mu       sync.Mutex
+	balances map[string]*balance
+	orders   []*order
+	books    map[string]yauga.UpbitOrderbookBlock
+	prices   map[string]yauga.Decimal
+	clock    time.Time
+}
+
+// 화폐별 잔고
+type balance struct {
+	balance     yauga.Decimal
+	locked      yauga.Decimal
+	avgBuyPrice yauga.Decimal
+}
+
+// 모의 주문
+type order struct {
+	uuid        string
+	identifier  string
+	market      string
+	side        yauga.OrderSide
+	ordType     yauga.OrderType
+	timeInForce yauga.TimeInForce
+	state       yauga.OrderState
+	createdAt   time.Time
+	// 주문 가격 (price 주문은 주문 총액)
+	price yauga.Decimal
+	// 주문량
+	volume yauga.Decimal
+	// 체결 후 남은 주문량
+	remaining yauga.Decimal
+	// 체결된 양
+	executed yauga.Decimal
+	// 체결된 총액
+	funds yauga.Decimal
+	// 묶어둔 금액/수량
+	locked yauga.Decimal
+	// 매수 시 예약된 수수료
+	reservedFee yauga.Decimal
+	// 사용된 수수료
+	paidFee yauga.Decimal
+	trades  []yauga.TradeBlock
+}
+
+var _ yauga.Exchange = (*Exchange)(nil)
+
+// Initialization
+//  화폐별 초기 잔고로 모의 거래소를 만듭니다.
+// Params:
+//	balances = 화폐별 잔고 (ex. {"KRW": yauga.MustDecimal("1000000")})
+func NewExchange(balances map[string]yauga.Decimal) *Exchange {
+	e := &Exchange{
+		balances: map[string]*balance{},
+		books:    map[string]yauga.UpbitOrderbookBlock{},
+		prices:   map[string]yauga.Decimal{},
+	}
+	for currency, amount := range balances {
+		e.balances[currency] = &balance{balance: amount}
+	}
+	return e
+}
+
+// 입금
+//  화폐 잔고를 늘립니다.
+func (e *Exchange) Deposit(currency string, amount yauga.Decimal) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b := e.account(currency)
+	b.balance = b.balance.Add(amount)
+}
+
+// 마켓별 수수료율
+func FeeRate(market string) yauga.Decimal {
+	switch quoteCurrency(market) {
+	case "BTC":
+		return yauga.NewDecimalFromFloat(FEE_RATE_BTC)
+	case "USDT":
+		return yauga.NewDecimalFromFloat(FEE_RATE_USDT)
+	default:
+		return yauga.NewDecimalFromFloat(FEE_RATE_KRW)
+	}
+}
+
+// 마켓별 최소 주문 금액
+func MinTotal(market string) yauga.Decimal {
+	switch quoteCurrency(market) {
+	case "BTC":
+		return yauga.NewDecimalFromFloat(MIN_TOTAL_BTC)
+	case "USDT":
+		return yauga.NewDecimalFromFloat(MIN_TOTAL_USDT)
+	default:
+		return yauga.NewDecimalFromFloat(MIN_TOTAL_KRW)
+	}
+}
+
+// 현재가 반영
+//  최근 체결 가격을 넘어선 지정가 주문을 주문 가격으로 모두 체결합니다.
+func (e *Exchange) UpdateTicker(ticker yauga.UpbitTickerBlock) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.tick(ticker.Timestamp)
+	price := yauga.NewDecimalFromFloat(ticker.TradePrice)
+	e.prices[ticker.Market] = price
+	for _, o := range e.orders {
+		if o.market != ticker.Market || o.state != yauga.ORDER_STATE_WAIT || o.ordType != yauga.ORDER_TYPE_LIMIT {
+			continue
+		}
+		if crosses(o, price) {
+			e.fill(o, o.price, o.remaining)
+		}
+	}
+}
+
+// 호가 반영
+//  걸려있는 지정가 주문을 넘어선 호가 잔량만큼 주문 가격으로 체결합니다.
+func (e *Exchange) UpdateOrderbook(book yauga.UpbitOrderbookBlock) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.tick(book.Timestamp)
+	book.OrderbookUnits = append([]yauga.OrderbookUnitBlock{}, book.OrderbookUnits...)
+	e.books[book.Market] = book
+	for _, o := range e.orders {
+		if o.market != book.Market || o.state != yauga.ORDER_STATE_WAIT || o.ordType != yauga.ORDER_TYPE_LIMIT {
+			continue
+		}
+		for _, level := range e.levels(o.market, o.side) {
+			if o.remaining.Sign() <= 0 || !crosses(o, level.price) {
+				break
+			}
+			qty := level.fit(o.remaining)
+			e.take(o.market, o.side, level.price, qty)
+			e.fill(o, o.price, qty)
+		}
+	}
+}
+
+// 시세 따라가기
+//  interval 마다 markets 의 호가와 현재가를 조회해 반영합니다. ctx 가 끝나거나 조회에 실패하면 멈춥니다.
+// Params:
+//	ctx = 중지용 context
+//	quotation = 시세를 조회할 구현 (ex. *yauga.Upbit)
+//	markets = 마켓 코드 목록 (ex. KRW-BTC)
+//	interval = 조회 주기
+func (e *Exchange) Poll(ctx context.Context, quotation yauga.Quotation, markets []string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		books := quotation.OrderbookContext(ctx, markets)
+		if books.Common.Error != nil {
+			return books.Common.Error
+		}
+		for _, book := range books.Response {
+			e.UpdateOrderbook(book)
+		}
+		tickers := quotation.TickerContext(ctx, markets)
+		if tickers.Common.Error != nil {
+			return tickers.Common.Error
+		}
+		for _, t := range tickers.Response {
+			e.UpdateTicker(t)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// [Exchange API] 전체 계좌 조회 @ accounts
+func (e *Exchange) Accounts() yauga.UpbitAccounts {
+	return e.AccountsContext(context.Background())
+}
+
+// Accounts 의 context 버전
+func (e *Exchange) AccountsContext(ctx context.Context) yauga.UpbitAccounts {
+	var res yauga.UpbitAccounts
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	currencies := make([]string, 0, len(e.balances))
+	for currency := range e.balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	res.Response = []yauga.UpbitAccountBlock{}
+	for _, currency := range currencies {
+		b := e.balances[currency]
+		if b.balance.Sign() <= 0 && b.locked.Sign() <= 0 {
+			continue
+		}
+		res.Response = append(res.Response, yauga.UpbitAccountBlock{
+			Currency:    currency,
+			Balance:     number(b.balance),
+			Locked:      number(b.locked),
+			AvgBuyPrice: number(b.avgBuyPrice),
+			UnitCurreny: "KRW",
+		})
+	}
+	res.Common.StatusCode = http.StatusOK
+	return res
+}
+
+// [Exchange API] 주문 가능 정보 @ orders/chance
+// Params:
+//	bidCurrencyTicker = 매수 시 사용할 통화
+//	AskCurrencyTicker = 매도 시 사용할 통화
+func (e *Exchange) OrdersChance(bidCurrencyTicker string, AskCurrencyTicker string) yauga.UpbitOrdersChance {
+	return e.OrdersChanceContext(context.Background(), bidCurrencyTicker, AskCurrencyTicker)
+}
+
+// OrdersChance 의 context 버전
+func (e *Exchange) OrdersChanceContext(ctx context.Context, bidCurrencyTicker string, AskCurrencyTicker string) yauga.UpbitOrdersChance {
+	var res yauga.UpbitOrdersChance
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	market := bidCurrencyTicker + "-" + AskCurrencyTicker
+	fee := number(FeeRate(market))
+	var maxTotal yauga.Decimal
+	if bidCurrencyTicker == "KRW" {
+		maxTotal = number(yauga.NewDecimalFromInt(MAX_TOTAL_KRW))
+	}
+	res.Response = yauga.UpbitOrdersChanceBlock{
+		BidFee: fee,
+		AskFee: fee,
+		Market: yauga.MarketBlock{
+			Id:         market,
+			Name:       AskCurrencyTicker + "/" + bidCurrencyTicker,
+			OrderTypes: []string{string(yauga.ORDER_TYPE_LIMIT), string(yauga.ORDER_TYPE_PRICE), string(yauga.ORDER_TYPE_MARKET), string(yauga.ORDER_TYPE_BEST)},
+			OrderSides: []string{string(yauga.ORDER_SIDE_ASK), string(yauga.ORDER_SIDE_BID)},
+			Bid:        yauga.BidAskBlock{Currency: bidCurrencyTicker, MinTotal: number(MinTotal(market))},
+			Ask:        yauga.BidAskBlock{Currency: AskCurrencyTicker, MinTotal: number(MinTotal(market))},
+			MaxTotal:   maxTotal,
+			State:      "active",
+		},
+		BidAccount: e.accountBlock(bidCurrencyTicker),
+		AskAccount: e.accountBlock(AskCurrencyTicker),
+	}
+	res.Common.StatusCode = http.StatusOK
+	return res
+}
+
+// [Exchange API] 개별 주문 조회 @ order
+func (e *Exchange) Order(opt yauga.OrderOption) yauga.UpbitOrder {
+	return e.OrderContext(context.Background(), opt)
+}
+
+// Order 의 context 버전
+func (e *Exchange) OrderContext(ctx context.Context, opt yauga.OrderOption) yauga.UpbitOrder {
+	var res yauga.UpbitOrder
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	if opt.Uuid == "" && opt.Identifier == "" {
+		res.Common.Error = &yauga.ValidationError{Field: "Uuid", Message: "Please configure Uuid or Identifier!"}
+		return res
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	o := e.find(opt)
+	if o == nil {
+		res.Common = failure(http.StatusNotFound, "order_not_found", "주문을 찾지 못했습니다.")
+		return res
+	}
+	res.Response = o.block()
+	res.Common.StatusCode = http.StatusOK
+	return res
+}
+
+// [Exchange API] 주문 리스트 조회 @ orders
+func (e *Exchange) Orders(opt yauga.OrdersOption) yauga.UpbitOrders {
+	return e.OrdersContext(context.Background(), opt)
+}
+
+// Orders 의 context 버전
+func (e *Exchange) OrdersContext(ctx context.Context, opt yauga.OrdersOption) yauga.UpbitOrders {
+	var res yauga.UpbitOrders
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	if opt.State != "" && len(opt.States) > 0 {
+		res.Common.Error = &yauga.ValidationError{Field: "States", Message: "State and States can not be used together!"}
+		return res
+	}
+	if opt.Limit > 100 {
+		res.Common.Error = &yauga.ValidationError{Field: "Limit", Message: "Limit field only accept until 100!"}
+		return res
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	states := opt.States
+	if opt.State != "" {
+		states = []yauga.OrderState{opt.State}
+	} else if len(states) <= 0 {
+		states = []yauga.OrderState{yauga.ORDER_STATE_WAIT}
+	}
+	var matched []*order
+	for _, o := range e.orders {
+		if opt.Market != "" && o.market != opt.Market {
+			continue
+		}
+		if !containsState(states, o.state) {
+			continue
+		}
+		if len(opt.Uuids) > 0 && !containsString(opt.Uuids, o.uuid) {
+			continue
+		}
+		if len(opt.Identifiers) > 0 && !containsString(opt.Identifiers, o.identifier) {
+			continue
+		}
+		matched = append(matched, o)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if opt.OrderBy == "asc" {
+			return matched[i].createdAt.Before(matched[j].createdAt)
+		}
+		return matched[i].createdAt.After(matched[j].createdAt)
+	})
+
+	page, limit := opt.Page, opt.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	res.Response = []yauga.UpbitOrderBlock{}
+	for i := (page - 1) * limit; i < len(matched) && i < page*limit; i++ {
+		res.Response = append(res.Response, matched[i].block())
+	}
+	res.Common.StatusCode = http.StatusOK
+	return res
+}
+
+// [Exchange API] 주문하기 @ orders
+//  잔고와 수수료를 묶고, 지금 호가로 체결 가능한 만큼 바로 체결합니다.
+//	최유리 주문은 상대 최우선 호가 하나에서만 체결되며, 시장가, 최유리, ioc/fok 주문의 남은 양은 바로 취소됩니다.
+func (e *Exchange) PlaceOrder(opt yauga.PlaceOrderOption) yauga.UpbitOrder {
+	return e.PlaceOrderContext(context.Background(), opt)
+}
+
+// PlaceOrder 의 context 버전
+func (e *Exchange) PlaceOrderContext(ctx context.Context, opt yauga.PlaceOrderOption) yauga.UpbitOrder {
+	var res yauga.UpbitOrder
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	if err := opt.Validate(); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	var price, volume yauga.Decimal
+	var err error
+	if opt.Price != "" {
+		if price, err = yauga.ParseDecimal(opt.Price); err != nil || price.Sign() <= 0 {
+			res.Common.Error = &yauga.ValidationError{Field: "Price", Message: "Price must be a positive number!"}
+			return res
+		}
+	}
+	if opt.Volume != "" {
+		if volume, err = yauga.ParseDecimal(opt.Volume); err != nil || volume.Sign() <= 0 {
+			res.Common.Error = &yauga.ValidationError{Field: "Volume", Message: "Volume must be a positive number!"}
+			return res
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	side := string(opt.Side)
+	if opt.Identifier != "" && e.find(yauga.OrderOption{Identifier: opt.Identifier}) != nil {
+		res.Common = failure(http.StatusBadRequest, "duplicated_identifier", "중복된 identifier 입니다.")
+		return res
+	}
+	if opt.OrdType == yauga.ORDER_TYPE_LIMIT && !price.RoundStep(yauga.TickSizeDecimal(opt.Market, price), yauga.ROUND_DOWN).Equal(price) {
+		res.Common = failure(http.StatusBadRequest, "invalid_price_"+side, "주문가격 단위를 잘못 입력하셨습니다.")
+		return res
+	}
+	if opt.OrdType != yauga.ORDER_TYPE_LIMIT && len(e.levels(opt.Market, opt.Side)) <= 0 {
+		res.Common.Error = errors.New("No market data for " + opt.Market + "!")
+		return res
+	}
+
+	// 주문 총액
+	total := price.Mul(volume)
+	switch opt.OrdType {
+	case yauga.ORDER_TYPE_PRICE, yauga.ORDER_TYPE_BEST:
+		if opt.Side == yauga.ORDER_SIDE_BID {
+			total = price
+		} else {
+			total = volume.Mul(e.levels(opt.Market, opt.Side)[0].price)
+		}
+	case yauga.ORDER_TYPE_MARKET:
+		total = volume.Mul(e.levels(opt.Market, opt.Side)[0].price)
+	}
+	if total.LessThan(MinTotal(opt.Market)) {
+		res.Common = failure(http.StatusBadRequest, "under_min_total_"+side, "최소주문금액 이상으로 주문해주세요")
+		return res
+	}
+
+	fee := FeeRate(opt.Market)
+	o := &order{
+		uuid:        uuid.New().String(),
+		identifier:  opt.Identifier,
+		market:      opt.Market,
+		side:        opt.Side,
+		ordType:     opt.OrdType,
+		timeInForce: opt.TimeInForce,
+		state:       yauga.ORDER_STATE_WAIT,
+		createdAt:   e.now(),
+		price:       price,
+		volume:      volume,
+		remaining:   volume,
+	}
+	if opt.Side == yauga.ORDER_SIDE_BID {
+		o.reservedFee = total.Mul(fee)
+		o.locked = total.Add(o.reservedFee)
+		quote := e.account(quoteCurrency(opt.Market))
+		if quote.balance.LessThan(o.locked) {
+			res.Common = failure(http.StatusBadRequest, "insufficient_funds_bid", "주문가능한 금액("+quoteCurrency(opt.Market)+")이 부족합니다.")
+			return res
+		}
+		quote.balance = quote.balance.Sub(o.locked)
+		quote.locked = quote.locked.Add(o.locked)
+	} else {
+		o.locked = volume
+		base := e.account(baseCurrency(opt.Market))
+		if base.balance.LessThan(o.locked) {
+			res.Common = failure(http.StatusBadRequest, "insufficient_funds_ask", "주문가능한 금액("+baseCurrency(opt.Market)+")이 부족합니다.")
+			return res
+		}
+		base.balance = base.balance.Sub(o.locked)
+		base.locked = base.locked.Add(o.locked)
+	}
+	e.orders = append(e.orders, o)
+
+	e.match(o)
+	res.Response = o.block()
+	res.Common.StatusCode = http.StatusCreated
+	return res
+}
+
+// [Exchange API] 주문 취소 접수 @ order
+//  묶여있던 잔고를 돌려줍니다.
+func (e *Exchange) CancelOrder(opt yauga.OrderOption) yauga.UpbitOrder {
+	return e.CancelOrderContext(context.Background(), opt)
+}
+
+// CancelOrder 의 context 버전
+func (e *Exchange) CancelOrderContext(ctx context.Context, opt yauga.OrderOption) yauga.UpbitOrder {
+	var res yauga.UpbitOrder
+	if res.Common.Error = ctx.Err(); res.Common.Error != nil {
+		return res
+	}
+	if opt.Uuid == "" && opt.Identifier == "" {
+		res.Common.Error = &yauga.ValidationError{Field: "Uuid", Message: "Please configure Uuid or Identifier!"}
+		return res
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	o := e.find(opt)
+	if o == nil {
+		res.Common = failure(http.StatusNotFound, "order_not_found", "주문을 찾지 못했습니다.")
+		return res
+	}
+	if o.state != yauga.ORDER_STATE_WAIT {
+		res.Common = failure(http.StatusBadRequest, "order_not_found", "이미 체결되었거나 취소된 주문입니다.")
+		return res
+	}
+	e.close(o, yauga.ORDER_STATE_CANCEL)
+	res.Response = o.block()
+	res.Common.StatusCode = http.StatusOK
+	return res
+}
+
+// 호가 한 단계
+type level struct {
+	price yauga.Decimal
+	size  yauga.Decimal
+	// 잔량 제한 없음 (호가 없이 최근 체결 가격을 쓸 때)
+	unlimited bool
+}
+
+// 호가 잔량 안에서 체결할 수 있는 양
+func (l level) fit(qty yauga.Decimal) yauga.Decimal {
+	if l.unlimited {
+		return qty
+	}
+	return yauga.MinDecimal(qty, l.size)
+}
+
+// 주문 상대편 호가
+//  매수는 매도 호가를 낮은 가격부터, 매도는 매수 호가를 높은 가격부터 반환합니다.
+//	호가가 없으면 최근 체결 가격을 잔량 제한 없이 사용합니다.
+func (e *Exchange) levels(market string, side yauga.OrderSide) []level {
+	var levels []level
+	if book, ok := e.books[market]; ok {
+		for _, unit := range book.OrderbookUnits {
+			if side == yauga.ORDER_SIDE_BID && unit.AskPrice > 0 && unit.AskSize > 0 {
+				levels = append(levels, level{price: yauga.NewDecimalFromFloat(unit.AskPrice), size: yauga.NewDecimalFromFloat(unit.AskSize)})
+			} else if side == yauga.ORDER_SIDE_ASK && unit.BidPrice > 0 && unit.BidSize > 0 {
+				levels = append(levels, level{price: yauga.NewDecimalFromFloat(unit.BidPrice), size: yauga.NewDecimalFromFloat(unit.BidSize)})
+			}
+		}
+		sort.Slice(levels, func(i, j int) bool {
+			if side == yauga.ORDER_SIDE_BID {
+				return levels[i].price.LessThan(levels[j].price)
+			}
+			return levels[i].price.GreaterThan(levels[j].price)
+		})
+	}
+	if len(levels) <= 0 {
+		if price, ok := e.prices[market]; ok && price.Sign() > 0 {
+			levels = append(levels, level{price: price, unlimited: true})
+		}
+	}
+	return levels
+}
+
+// 호가 잔량 소진
+//  같은 호가로 두 번 체결되지 않도록 저장된 호가에서 잔량을 뺍니다.
+//	호가는 업비트가 Double 로 보내는 값이라 그대로 float64 로 둡니다.
+func (e *Exchange) take(market string, side yauga.OrderSide, price yauga.Decimal, qty yauga.Decimal) {
+	book, ok := e.books[market]
+	if !ok {
+		return
+	}
+	for i := range book.OrderbookUnits {
+		unit := &book.OrderbookUnits[i]
+		if side == yauga.ORDER_SIDE_BID && yauga.NewDecimalFromFloat(unit.AskPrice).Equal(price) {
+			unit.AskSize = yauga.MaxDecimal(zero, yauga.NewDecimalFromFloat(unit.AskSize).Sub(qty)).Float64()
+		} else if side == yauga.ORDER_SIDE_ASK && yauga.NewDecimalFromFloat(unit.BidPrice).Equal(price) {
+			unit.BidSize = yauga.MaxDecimal(zero, yauga.NewDecimalFromFloat(unit.BidSize).Sub(qty)).Float64()
+		}
+	}
+}
+
+// 지정가 주문이 호가를 넘어섰는지 확인
+func crosses(o *order, price yauga.Decimal) bool {
+	if o.side == yauga.ORDER_SIDE_BID {
+		return !price.GreaterThan(o.price)
+	}
+	return !price.LessThan(o.price)
+}
+
+// 주문 직후 체결
+//  지금 호가로 체결 가능한 만큼 호가 가격으로 체결합니다.
+//	최유리 주문은 상대 최우선 호가 가격의 지정가 주문이므로 첫 호가에서만 체결합니다.
+func (e *Exchange) match(o *order) {
+	levels := e.levels(o.market, o.side)
+	if o.ordType == yauga.ORDER_TYPE_BEST && len(levels) > 1 {
+		levels = levels[:1]
+	}
+	if o.timeInForce == yauga.TIME_IN_FORCE_FOK && !e.fillable(o, levels) {
+		e.close(o, yauga.ORDER_STATE_CANCEL)
+		return
+	}
+
+	// price 주문, 최유리 매수 주문은 남은 주문 총액
+	budget := o.price.Sub(o.funds)
+	for _, level := range levels {
+		var qty yauga.Decimal
+		switch {
+		case o.ordType == yauga.ORDER_TYPE_LIMIT:
+			if crosses(o, level.price) {
+				qty = level.fit(o.remaining)
+			}
+		case o.side == yauga.ORDER_SIDE_BID:
+			// 호가 가격은 levels 에서 0 보다 큰 것만 남기므로 나눗셈 오류는 없음
+			affordable, _ := budget.Div(level.price, yauga.VOLUME_PRECISION, yauga.ROUND_DOWN)
+			qty = level.fit(affordable)
+			budget = budget.Sub(qty.Mul(level.price))
+		default:
+			qty = level.fit(o.remaining)
+		}
+		if qty.Sign() <= 0 {
+			break
+		}
+		e.take(o.market, o.side, level.price, qty)
+		e.fill(o, level.price, qty)
+		if o.state != yauga.ORDER_STATE_WAIT || (o.ordType != yauga.ORDER_TYPE_LIMIT && o.side == yauga.ORDER_SIDE_BID && budget.Sign() <= 0) {
+			break
+		}
+	}
+
+	if o.state == yauga.ORDER_STATE_WAIT && (o.ordType != yauga.ORDER_TYPE_LIMIT || o.timeInForce != yauga.TIME_IN_FORCE_NONE) {
+		e.close(o, yauga.ORDER_STATE_CANCEL)
+	}
+}
+
+// fok 주문이 모두 체결 가능한지 확인
+func (e *Exchange) fillable(o *order, levels []level) bool {
+	need := o.remaining
+	if o.side == yauga.ORDER_SIDE_BID && o.ordType != yauga.ORDER_TYPE_LIMIT {
+		need = o.price
+	}
+	for _, level := range levels {
+		if o.ordType == yauga.ORDER_TYPE_LIMIT && !crosses(o, level.price) {
+			break
+		}
+		if level.unlimited {
+			return true
+		}
+		if o.side == yauga.ORDER_SIDE_BID && o.ordType != yauga.ORDER_TYPE_LIMIT {
+			need = need.Sub(level.price.Mul(level.size))
+		} else {
+			need = need.Sub(level.size)
+		}
+		if need.Sign() <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 체결
+//  잔고를 옮기고 수수료를 떼며, 다 체결되면 주문을 끝냅니다.
+func (e *Exchange) fill(o *order, price yauga.Decimal, qty yauga.Decimal) {
+	if qty.Sign() <= 0 {
+		return
+	}
+	funds := price.Mul(qty)
+	fee := funds.Mul(FeeRate(o.market))
+	quote := e.account(quoteCurrency(o.market))
+	base := e.account(baseCurrency(o.market))
+
+	if o.side == yauga.ORDER_SIDE_BID {
+		// 지정가는 주문 가격 기준으로 묶어둔 금액을 풀고 남는 금액은 돌려줌
+		release := funds.Add(fee)
+		if o.ordType == yauga.ORDER_TYPE_LIMIT {
+			release = o.price.Mul(qty).Mul(one.Add(FeeRate(o.market)))
+			quote.balance = quote.balance.Add(release.Sub(funds.Add(fee)))
+		}
+		quote.locked = quote.locked.Sub(release)
+		o.locked = o.locked.Sub(release)
+		held := base.balance.Add(base.locked)
+		if avg, err := base.avgBuyPrice.Mul(held).Add(funds).Div(held.Add(qty), yauga.VOLUME_PRECISION, yauga.ROUND_HALF_UP); err == nil {
+			base.avgBuyPrice = avg
+		}
+		base.balance = base.balance.Add(qty)
+	} else {
+		base.locked = base.locked.Sub(qty)
+		o.locked = o.locked.Sub(qty)
+		quote.balance = quote.balance.Add(funds.Sub(fee))
+	}
+
+	o.executed = o.executed.Add(qty)
+	o.funds = o.funds.Add(funds)
+	o.paidFee = o.paidFee.Add(fee)
+	if o.volume.Sign() > 0 {
+		o.remaining = o.remaining.Sub(qty)
+	}
+	o.trades = append(o.trades, yauga.TradeBlock{
+		Market:    o.market,
+		Uuid:      uuid.New().String(),
+		Price:     number(price),
+		Volume:    number(qty),
+		Funds:     number(funds),
+		Side:      string(o.side),
+		CreatedAt: e.now(),
+	})
+
+	if o.volume.Sign() > 0 && o.remaining.Sign() <= 0 {
+		o.remaining = zero
+		e.close(o, yauga.ORDER_STATE_DONE)
+	} else if o.side == yauga.ORDER_SIDE_BID && o.ordType != yauga.ORDER_TYPE_LIMIT && o.price.Sub(o.funds).LessThan(price.Mul(minVolume)) {
+		// 남은 주문 총액으로 최소 수량도 살 수 없음
+		e.close(o, yauga.ORDER_STATE_DONE)
+	}
+}
+
+// 주문 끝내기
+//  묶여있던 잔고를 돌려줍니다.
+func (e *Exchange) close(o *order, state yauga.OrderState) {
+	if o.side == yauga.ORDER_SIDE_BID {
+		quote := e.account(quoteCurrency(o.market))
+		quote.locked = quote.locked.Sub(o.locked)
+		quote.balance = quote.balance.Add(o.locked)
+	} else {
+		base := e.account(baseCurrency(o.market))
+		base.locked = base.locked.Sub(o.locked)
+		base.balance = base.balance.Add(o.locked)
+	}
+	o.locked = zero
+	o.state = state
+}
+
+// 주문 찾기
+func (e *Exchange) find(opt yauga.OrderOption) *order {
+	for _, o := range e.orders {
+		if (opt.Uuid != "" && o.uuid == opt.Uuid) || (opt.Uuid == "" && opt.Identifier != "" && o.identifier == opt.Identifier) {
+			return o
+		}
+	}
+	return nil
+}
+
+// 화폐 잔고 취득 (없으면 만듦)
+func (e *Exchange) account(currency string) *balance {
+	b, ok := e.balances[currency]
+	if !ok {
+		b = &balance{}
+		e.balances[currency] = b
+	}
+	return b
+}
+
+// 주문 가능 정보용 계좌 Block
+func (e *Exchange) accountBlock(currency string) yauga.BidAskAccountBlock {
+	b := e.account(currency)
+	return yauga.BidAskAccountBlock{
+		Currency:     currency,
+		Balance:      number(b.balance),
+		Locked:       number(b.locked),
+		AvgBuyPrice:  number(b.avgBuyPrice),
+		UnitCurrency: "KRW",
+	}
+}
+
+// 시세 시각 반영
+//  재생한 시세라도 주문, 체결 시각이 시세 시각을 따르도록 합니다.
+func (e *Exchange) tick(timestamp int64) {
+	if timestamp > 0 {
+		e.clock = time.Unix(0, timestamp*int64(time.Millisecond))
+	}
+}
+
+// 현재 시각
+func (e *Exchange) now() time.Time {
+	if !e.clock.IsZero() {
+		return e.clock
+	}
+	return time.Now()
+}
+
+// 주문 Block 만들기
+func (o *order) block() yauga.UpbitOrderBlock {
+	var price, volume, remaining yauga.Decimal
+	if o.price.Sign() > 0 {
+		price = number(o.price)
+	}
+	if o.volume.Sign() > 0 {
+		volume = number(o.volume)
+		remaining = number(o.remaining)
+	}
+	return yauga.UpbitOrderBlock{
+		Uuid:            o.uuid,
+		Side:            string(o.side),
+		OrdType:         string(o.ordType),
+		Price:           price,
+		State:           string(o.state),
+		Market:          o.market,
+		CreatedAt:       o.createdAt,
+		Volume:          volume,
+		RemainingVolume: remaining,
+		ReservedFee:     number(o.reservedFee),
+		RemainingFee:    number(yauga.MaxDecimal(zero, o.reservedFee.Sub(o.paidFee))),
+		PaidFee:         number(o.paidFee),
+		Locked:          number(o.locked),
+		ExecutedVolume:  number(o.executed),
+		TradeCount:      len(o.trades),
+		Trades:          append([]yauga.TradeBlock{}, o.trades...),
+	}
+}
+
+// 업비트 오류 응답과 같은 모양의 결과
+func failure(status int, name string, message string) yauga.UpbitCommonBlock {
+	body, _ := json.Marshal(yauga.UpbitErrorResponse{ErrorBlock: yauga.UpbitErrorBlock{Name: name, Message: message}})
+	return yauga.UpbitCommonBlock{StatusCode: status, Error: yauga.ParseAPIError(status, body)}
+}
+
+// 마켓의 기준 화폐 (ex. KRW-BTC → KRW)
+func quoteCurrency(market string) string {
+	return strings.SplitN(market, "-", 2)[0]
+}
+
+// 마켓의 거래 화폐 (ex. KRW-BTC → BTC)
+func baseCurrency(market string) string {
+	parts := strings.SplitN(market, "-", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// NumberString 만들기
+//  소수점 8자리에서 반올림하고 끝의 0 을 지웁니다. (ex. 490245.000000 -> 490245)
+func number(v yauga.Decimal) yauga.Decimal {
+	s := v.Round(yauga.VOLUME_PRECISION, yauga.ROUND_HALF_UP).String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return yauga.MustDecimal(s)
+}
+
+// 주문 상태 목록에 포함되어 있는지 확인
+func containsState(list []yauga.OrderState, target yauga.OrderState) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// 문자열 목록에 포함되어 있는지 확인
+func containsString(list []string, target string) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
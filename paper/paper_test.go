@@ -0,0 +1,131 @@
+package paper
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"errors"
+	"testing"
+
+	"github.com/davidjung-kr/yauga"
+)
+
+// 테스트 호가
+func testOrderbook() yauga.UpbitOrderbookBlock {
+	return yauga.UpbitOrderbookBlock{
+		Market: "KRW-BTC",
+		OrderbookUnits: []yauga.OrderbookUnitBlock{
+			{AskPrice: 50001000, BidPrice: 50000000, AskSize: 0.1, BidSize: 0.1},
+			{AskPrice: 50002000, BidPrice: 49999000, AskSize: 1, BidSize: 1},
+		},
+	}
+}
+
+// 지정가 주문 테스트
+//  걸어둔 매수 주문이 시세가 내려오면 체결되고 수수료가 빠져야 함
+func TestPaperLimitOrder(t *testing.T) {
+	ex := NewExchange(map[string]yauga.Decimal{"KRW": yauga.MustDecimal("1000000")})
+	ex.UpdateOrderbook(testOrderbook())
+
+	x := ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Volume: "0.01", Price: "49000000", Identifier: "paper-1"})
+	if x.Common.Error != nil || x.Response.State != "wait" || x.Response.Locked.String() != "490245" {
+		t.Fatalf("TestPaperLimitOrder | State:[%s], Locked:[%s], placeOrderErr:[%s]", x.Response.State, x.Response.Locked, x.Common.Error)
+	}
+	chance := ex.OrdersChance("KRW", "BTC")
+	if chance.Response.BidAccount.Balance.String() != "509755" || chance.Response.BidAccount.Locked.String() != "490245" {
+		t.Errorf("TestPaperLimitOrder | Balance:[%s], Locked:[%s]", chance.Response.BidAccount.Balance, chance.Response.BidAccount.Locked)
+	}
+
+	ex.UpdateTicker(yauga.UpbitTickerBlock{Market: "KRW-BTC", TradePrice: 48900000})
+	y := ex.Order(yauga.OrderOption{Identifier: "paper-1"})
+	if y.Response.State != "done" || y.Response.ExecutedVolume.String() != "0.01" || y.Response.PaidFee.String() != "245" || len(y.Response.Trades) != 1 {
+		t.Errorf("TestPaperLimitOrder | State:[%s], ExecutedVolume:[%s], PaidFee:[%s]", y.Response.State, y.Response.ExecutedVolume, y.Response.PaidFee)
+	}
+	chance = ex.OrdersChance("KRW", "BTC")
+	if chance.Response.BidAccount.Balance.String() != "509755" || chance.Response.BidAccount.Locked.String() != "0" || chance.Response.AskAccount.Balance.String() != "0.01" {
+		t.Errorf("TestPaperLimitOrder | KRW:[%s], BTC:[%s]", chance.Response.BidAccount.Balance, chance.Response.AskAccount.Balance)
+	}
+}
+
+// 시장가 주문 테스트
+//  호가를 따라 여러 단계에 걸쳐 체결되어야 함
+func TestPaperMarketOrder(t *testing.T) {
+	ex := NewExchange(map[string]yauga.Decimal{"BTC": yauga.MustDecimal("0.5")})
+	ex.UpdateOrderbook(testOrderbook())
+
+	x := ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_ASK, OrdType: yauga.ORDER_TYPE_MARKET, Volume: "0.2"})
+	if x.Common.Error != nil || x.Response.State != "done" || len(x.Response.Trades) != 2 {
+		t.Fatalf("TestPaperMarketOrder | State:[%s], Trades:[%d], placeOrderErr:[%s]", x.Response.State, len(x.Response.Trades), x.Common.Error)
+	}
+	if x.Response.Trades[0].Price.String() != "50000000" || x.Response.Trades[1].Price.String() != "49999000" {
+		t.Errorf("TestPaperMarketOrder | Prices:[%s, %s]", x.Response.Trades[0].Price, x.Response.Trades[1].Price)
+	}
+	chance := ex.OrdersChance("KRW", "BTC")
+	if chance.Response.BidAccount.Balance.String() != "9994900.05" || chance.Response.AskAccount.Balance.String() != "0.3" {
+		t.Errorf("TestPaperMarketOrder | KRW:[%s], BTC:[%s]", chance.Response.BidAccount.Balance, chance.Response.AskAccount.Balance)
+	}
+
+	// 작은 잔고도 잃지 않아야 함
+	ex.Deposit("BTC", yauga.MustDecimal("0.00012345"))
+	if chance = ex.OrdersChance("KRW", "BTC"); chance.Response.AskAccount.Balance.String() != "0.30012345" {
+		t.Errorf("TestPaperMarketOrder | BTC:[%s]", chance.Response.AskAccount.Balance)
+	}
+}
+
+// 최유리 주문 테스트
+//  상대 최우선 호가 하나에서만 체결되고 남은 양은 취소되어야 함
+func TestPaperBestOrder(t *testing.T) {
+	ex := NewExchange(map[string]yauga.Decimal{"KRW": yauga.MustDecimal("10000000"), "BTC": yauga.MustDecimal("0.5")})
+	ex.UpdateOrderbook(testOrderbook())
+
+	x := ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_ASK, OrdType: yauga.ORDER_TYPE_BEST, Volume: "0.2", TimeInForce: yauga.TIME_IN_FORCE_IOC})
+	if x.Common.Error != nil || x.Response.State != "cancel" || len(x.Response.Trades) != 1 || x.Response.Trades[0].Price.String() != "50000000" || x.Response.ExecutedVolume.String() != "0.1" {
+		t.Fatalf("TestPaperBestOrder | State:[%s], Trades:[%v], placeOrderErr:[%s]", x.Response.State, x.Response.Trades, x.Common.Error)
+	}
+	x = ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_BEST, Price: "6000000", TimeInForce: yauga.TIME_IN_FORCE_IOC})
+	if x.Common.Error != nil || x.Response.State != "cancel" || len(x.Response.Trades) != 1 || x.Response.Trades[0].Price.String() != "50001000" || x.Response.ExecutedVolume.String() != "0.1" {
+		t.Errorf("TestPaperBestOrder | State:[%s], Trades:[%v], placeOrderErr:[%s]", x.Response.State, x.Response.Trades, x.Common.Error)
+	}
+
+	// fok 는 첫 호가만으로 모두 체결할 수 없으면 취소
+	ex.UpdateOrderbook(testOrderbook())
+	x = ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_ASK, OrdType: yauga.ORDER_TYPE_BEST, Volume: "0.2", TimeInForce: yauga.TIME_IN_FORCE_FOK})
+	if x.Common.Error != nil || x.Response.State != "cancel" || len(x.Response.Trades) != 0 {
+		t.Errorf("TestPaperBestOrder | State:[%s], Trades:[%v], placeOrderErr:[%s]", x.Response.State, x.Response.Trades, x.Common.Error)
+	}
+}
+
+// 주문 거절, 취소 테스트
+func TestPaperRejectAndCancel(t *testing.T) {
+	ex := NewExchange(map[string]yauga.Decimal{"KRW": yauga.MustDecimal("100000")})
+	ex.UpdateOrderbook(testOrderbook())
+
+	x := ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Volume: "0.01", Price: "49000000"})
+	if x.Common.StatusCode != 400 || !errors.Is(x.Common.Error, yauga.ErrInsufficientFundsBid) {
+		t.Errorf("TestPaperRejectAndCancel | Status:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	x = ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Volume: "0.001", Price: "49000500"})
+	if x.Common.StatusCode != 400 || !errors.Is(x.Common.Error, yauga.ErrInvalidPriceBid) {
+		t.Errorf("TestPaperRejectAndCancel | Status:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	x = ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_PRICE, Price: "1000"})
+	if x.Common.StatusCode != 400 || !errors.Is(x.Common.Error, yauga.ErrUnderMinTotalBid) {
+		t.Errorf("TestPaperRejectAndCancel | Status:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+
+	x = ex.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Volume: "0.001", Price: "49000000"})
+	y := ex.CancelOrder(yauga.OrderOption{Uuid: x.Response.Uuid})
+	if y.Common.Error != nil || y.Response.State != "cancel" {
+		t.Errorf("TestPaperRejectAndCancel | State:[%s], cancelOrderErr:[%s]", y.Response.State, y.Common.Error)
+	}
+	chance := ex.OrdersChance("KRW", "BTC")
+	if chance.Response.Market.Bid.Currency != "KRW" || chance.Response.Market.Ask.Currency != "BTC" {
+		t.Errorf("TestPaperRejectAndCancel | Bid:[%s], Ask:[%s]", chance.Response.Market.Bid.Currency, chance.Response.Market.Ask.Currency)
+	}
+	if chance.Response.BidAccount.Balance.String() != "100000" || chance.Response.BidAccount.Locked.String() != "0" {
+		t.Errorf("TestPaperRejectAndCancel | Balance:[%s], Locked:[%s]", chance.Response.BidAccount.Balance, chance.Response.BidAccount.Locked)
+	}
+}
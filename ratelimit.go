@@ -0,0 +1,223 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// 시세 조회 그룹별 초당 최대 요청 수
+	RATE_LIMIT_QUOTATION_PER_SECOND = 10
+	// 거래(default 그룹) 초당 최대 요청 수
+	RATE_LIMIT_EXCHANGE_PER_SECOND = 30
+	// 주문(order 그룹) 초당 최대 요청 수
+	RATE_LIMIT_ORDER_PER_SECOND = 8
+)
+
+// 요청 수 제한 그룹별 기본 초당 요청 수
+var defaultRateLimits = map[string]int{
+	"market":      RATE_LIMIT_QUOTATION_PER_SECOND,
+	"candles":     RATE_LIMIT_QUOTATION_PER_SECOND,
+	"crix-trades": RATE_LIMIT_QUOTATION_PER_SECOND,
+	"ticker":      RATE_LIMIT_QUOTATION_PER_SECOND,
+	"orderbook":   RATE_LIMIT_QUOTATION_PER_SECOND,
+	"default":     RATE_LIMIT_EXCHANGE_PER_SECOND,
+	"order":       RATE_LIMIT_ORDER_PER_SECOND,
+}
+
+// 요청 수 제한 그룹의 남은 요청 수
+type RateLimit struct {
+	// 요청 수 제한 그룹 (ex. default, market, candles, order)
+	Group string
+	// 이번 초에 남은 요청 수
+	Sec int
+	// 이번 분에 남은 요청 수 (헤더에 없으면 -1)
+	Min int
+	// 마지막으로 Remaining-Req 헤더를 받은 시각
+	UpdatedAt time.Time
+}
+
+// Remaining-Req 헤더 기반 요청 수 제한기
+//  응답의 Remaining-Req 헤더로 그룹별 남은 요청 수(토큰)를 맞추고,
+//	토큰이 없으면 다음 초(혹은 분)가 될 때까지 요청을 기다리게 합니다.
+//	요청 경로가 어느 그룹인지는 응답 헤더로 배우며, 배우기 전에는 업비트 문서 기준 그룹을 사용합니다.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*rateBucket
+	routes  map[string]string
+}
+
+// 그룹별 토큰 버킷
+type rateBucket struct {
+	capacity  int
+	sec       int
+	secReset  time.Time
+	min       int
+	minReset  time.Time
+	updatedAt time.Time
+}
+
+// Initialization
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{buckets: map[string]*rateBucket{}, routes: map[string]string{}}
+}
+
+// 요청 경로의 그룹 취득
+// Params:
+//	method = HTTP 메소드
+//	path = 요청 경로 (ex. /v1/orders)
+func (r *RateLimiter) Group(method string, path string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.group(method, path)
+}
+
+// 요청 가능할 때까지 기다리기
+//  그룹에 남은 토큰이 있으면 하나를 쓰고 바로 반환합니다. ctx 가 끝나면 ctx.Err() 를 반환합니다.
+// Params:
+//	ctx = 요청 context
+//	method = HTTP 메소드
+//	path = 요청 경로 (ex. /v1/orders)
+func (r *RateLimiter) Wait(ctx context.Context, method string, path string) error {
+	for {
+		r.mu.Lock()
+		b := r.bucket(r.group(method, path))
+		now := time.Now()
+		if !now.Before(b.secReset) {
+			b.sec = b.capacity
+			b.secReset = now.Truncate(time.Second).Add(time.Second)
+		}
+		if b.min == 0 && !now.Before(b.minReset) {
+			b.min = -1
+		}
+
+		var until time.Time
+		switch {
+		case b.min == 0:
+			until = b.minReset
+		case b.sec <= 0:
+			until = b.secReset
+		default:
+			b.sec--
+			if b.min > 0 {
+				b.min--
+			}
+			r.mu.Unlock()
+			return nil
+		}
+		r.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(until))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// 응답 헤더 반영
+//  Remaining-Req 헤더가 있으면 요청 경로의 그룹과 남은 요청 수를 갱신합니다.
+// Params:
+//	method = HTTP 메소드
+//	path = 요청 경로 (ex. /v1/orders)
+//	header = 응답 헤더
+func (r *RateLimiter) Update(method string, path string, header http.Header) {
+	group, min, sec, ok := parseRemainingReq(header.Get("Remaining-Req"))
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.routes[method+" "+path] = group
+	b := r.bucket(group)
+	now := time.Now()
+	if sec+1 > b.capacity {
+		b.capacity = sec + 1
+	}
+	b.sec = sec
+	b.secReset = now.Truncate(time.Second).Add(time.Second)
+	b.min = min
+	if min >= 0 {
+		b.minReset = now.Truncate(time.Minute).Add(time.Minute)
+	}
+	b.updatedAt = now
+}
+
+// 그룹의 남은 요청 수
+func (r *RateLimiter) Remaining(group string) RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.remaining(group, r.bucket(group))
+}
+
+// 전체 그룹의 남은 요청 수
+func (r *RateLimiter) Snapshot() map[string]RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	limits := map[string]RateLimit{}
+	for group, b := range r.buckets {
+		limits[group] = r.remaining(group, b)
+	}
+	return limits
+}
+
+// 남은 요청 수 계산
+//  초가 바뀌었으면 가득 찬 것으로 봅니다. 호출 전에 mu 를 잡고 있어야 합니다.
+func (r *RateLimiter) remaining(group string, b *rateBucket) RateLimit {
+	limit := RateLimit{Group: group, Sec: b.sec, Min: b.min, UpdatedAt: b.updatedAt}
+	if !time.Now().Before(b.secReset) {
+		limit.Sec = b.capacity
+	}
+	return limit
+}
+
+// 요청 경로의 그룹
+//  호출 전에 mu 를 잡고 있어야 합니다.
+func (r *RateLimiter) group(method string, path string) string {
+	if group, ok := r.routes[method+" "+path]; ok {
+		return group
+	}
+	switch {
+	case method == http.MethodPost && path == "/v1/orders":
+		return "order"
+	case strings.HasPrefix(path, "/v1/market/"):
+		return "market"
+	case strings.HasPrefix(path, "/v1/candles/"):
+		return "candles"
+	case strings.HasPrefix(path, "/v1/trades/"):
+		return "crix-trades"
+	case path == "/v1/ticker":
+		return "ticker"
+	case path == "/v1/orderbook":
+		return "orderbook"
+	default:
+		return "default"
+	}
+}
+
+// 그룹 버킷 취득 (없으면 만듦)
+//  호출 전에 mu 를 잡고 있어야 합니다.
+func (r *RateLimiter) bucket(group string) *rateBucket {
+	b, ok := r.buckets[group]
+	if !ok {
+		capacity, ok := defaultRateLimits[group]
+		if !ok {
+			capacity = RATE_LIMIT_QUOTATION_PER_SECOND
+		}
+		b = &rateBucket{capacity: capacity, sec: capacity, min: -1}
+		r.buckets[group] = b
+	}
+	return b
+}
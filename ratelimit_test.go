@@ -0,0 +1,75 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// RateLimiter 테스트
+//  헤더로 그룹을 배우고, 남은 요청이 없으면 다음 초까지 기다려야 함
+func TestUpbitRateLimiter(t *testing.T) {
+	rl := NewRateLimiter()
+	if x := rl.Group(http.MethodPost, "/v1/orders"); x != "order" {
+		t.Errorf("TestUpbitRateLimiter | Group:[%s]", x)
+	}
+	if x := rl.Group(http.MethodGet, "/v1/candles/minutes/1"); x != "candles" {
+		t.Errorf("TestUpbitRateLimiter | Group:[%s]", x)
+	}
+
+	header := http.Header{}
+	header.Set("Remaining-Req", "group=status-wallet; min=59; sec=0")
+	before := time.Now()
+	rl.Update(http.MethodGet, "/v1/status/wallet", header)
+	if x := rl.Group(http.MethodGet, "/v1/status/wallet"); x != "status-wallet" {
+		t.Errorf("TestUpbitRateLimiter | Group:[%s]", x)
+	}
+	if x := rl.Snapshot()["status-wallet"]; x.Min != 59 {
+		t.Errorf("TestUpbitRateLimiter | RateLimit:[%+v]", x)
+	}
+	if err := rl.Wait(context.Background(), http.MethodGet, "/v1/status/wallet"); err != nil {
+		t.Errorf("TestUpbitRateLimiter | waitErr:[%s]", err)
+	}
+	if !time.Now().Truncate(time.Second).After(before.Truncate(time.Second)) {
+		t.Errorf("TestUpbitRateLimiter | Wait returned in the same second")
+	}
+}
+
+// 요청 수 제한 테스트
+//  서버가 sec=0 을 알려주면 다음 요청은 다음 초에 도착해야 함
+func TestUpbitRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	var received []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		received = append(received, time.Now())
+		mu.Unlock()
+		w.Header().Set("Remaining-Req", "group=ticker; min=599; sec=0")
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":50000000.0}]`))
+	}))
+	defer server.Close()
+
+	upbit := NewUpbit("", WithHTTPClient(server.Client()), WithBaseURL(server.URL))
+	for i := 0; i < 2; i++ {
+		if x := upbit.Ticker([]string{"KRW-BTC"}); x.Common.Error != nil {
+			t.Errorf("TestUpbitRateLimit | Status:[%d], tickerErr:[%s]", x.Common.StatusCode, x.Common.Error)
+		}
+	}
+	if len(received) != 2 || !received[1].Truncate(time.Second).After(received[0].Truncate(time.Second)) {
+		t.Errorf("TestUpbitRateLimit | Received:[%v]", received)
+	}
+	if x := upbit.RateLimiter().Remaining("ticker"); x.Group != "ticker" || x.Min != 599 {
+		t.Errorf("TestUpbitRateLimit | RateLimit:[%+v]", x)
+	}
+}
@@ -0,0 +1,114 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// 기본 최대 시도 횟수 (첫 요청 포함)
+	RETRY_MAX_ATTEMPTS = 3
+	// 기본 첫 재시도 대기 시간
+	RETRY_MIN_BACKOFF = 200 * time.Millisecond
+	// 기본 최대 재시도 대기 시간
+	RETRY_MAX_BACKOFF = 5 * time.Second
+	// 기본 대기 시간 흔들기 비율 (±20%)
+	RETRY_JITTER = 0.2
+)
+
+// 재시도 정책
+//  5xx, 429(Too Many Requests), 네트워크 오류를 재시도합니다.
+//	GET 요청은 항상, 주문하기(POST)는 identifier 가 있을 때만 재시도합니다. 주문 취소(DELETE)는 재시도하지 않습니다.
+//	대기 시간은 MinBackoff 부터 두 배씩 늘어나며, Retry-After, Remaining-Req 헤더가 더 길면 그만큼 기다립니다.
+//	재시도를 끄려면 MaxAttempts 를 1 로 세팅하세요.
+type RetryPolicy struct {
+	// 최대 시도 횟수 (첫 요청 포함, 1 이하면 재시도하지 않음)
+	MaxAttempts int
+	// 첫 재시도 대기 시간
+	MinBackoff time.Duration
+	// 최대 재시도 대기 시간
+	MaxBackoff time.Duration
+	// 대기 시간을 무작위로 흔드는 비율 (0~1, ex. 0.2 = ±20%)
+	Jitter float64
+}
+
+// 기본 재시도 정책
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: RETRY_MAX_ATTEMPTS, MinBackoff: RETRY_MIN_BACKOFF, MaxBackoff: RETRY_MAX_BACKOFF, Jitter: RETRY_JITTER}
+}
+
+// 재시도 전 대기 시간
+// Params:
+//	attempt = 방금 실패한 시도 번호 (1 부터)
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(wait))
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+// 재시도해도 안전한 요청인지 확인
+//  GET 과 identifier 가 있는 주문하기(POST)만 같은 요청을 다시 보내도 결과가 달라지지 않습니다.
+func idempotent(method string, params url.Values) bool {
+	switch method {
+	case http.MethodGet:
+		return true
+	case http.MethodPost:
+		return params.Get("identifier") != ""
+	}
+	return false
+}
+
+// 재시도할 만한 실패인지 확인
+//  transient 는 응답을 받지 못한 네트워크 오류 여부입니다. ctx 가 끝났으면 재시도하지 않습니다.
+func retryable(ctx context.Context, common UpbitCommonBlock, transient bool) bool {
+	if common.Error == nil || ctx.Err() != nil {
+		return false
+	}
+	if transient {
+		return true
+	}
+	return errors.Is(common.Error, ErrTooManyRequests) || errors.Is(common.Error, ErrServerError)
+}
+
+// 응답 헤더가 요구하는 최소 대기 시간
+//  Retry-After(초) 헤더와 Remaining-Req 헤더의 남은 요청 수(sec=0 이면 다음 초, min=0 이면 다음 분)를 봅니다.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+		wait = time.Duration(seconds) * time.Second
+	}
+	if _, min, sec, ok := parseRemainingReq(header.Get("Remaining-Req")); ok {
+		var until time.Time
+		if min == 0 {
+			until = now.Truncate(time.Minute).Add(time.Minute)
+		} else if sec == 0 {
+			until = now.Truncate(time.Second).Add(time.Second)
+		}
+		if d := until.Sub(now); !until.IsZero() && d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
@@ -0,0 +1,102 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// RetryPolicy 대기 시간 테스트
+//  MinBackoff 부터 두 배씩 늘고 MaxBackoff 를 넘지 않아야 함
+func TestUpbitRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second} {
+		if x := policy.Backoff(attempt); x != want {
+			t.Errorf("TestUpbitRetryBackoff | Attempt:[%d], Backoff:[%s]", attempt, x)
+		}
+	}
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if x := policy.Backoff(1); x < 50*time.Millisecond || x > 150*time.Millisecond {
+			t.Errorf("TestUpbitRetryBackoff | Jitter Backoff:[%s]", x)
+		}
+	}
+}
+
+// 재시도 테스트
+//  GET 은 5xx, 429 를 재시도하고, 주문하기는 identifier 가 있을 때만 재시도해야 함
+func TestUpbitRetry(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}))
+
+	server.FailNext("/v1/ticker", 2, http.StatusServiceUnavailable, "service_unavailable", "")
+	var info ResponseInfo
+	ticker, err := NewClient(upbit).Ticker(context.Background(), []string{"KRW-BTC"}, WithResponseInfo(&info))
+	if err != nil || len(ticker) != 1 || info.Attempts != 3 {
+		t.Errorf("TestUpbitRetry | Attempts:[%d], tickerErr:[%s]", info.Attempts, err)
+	}
+
+	server.FailNext("/v1/candles/minutes/1", 1, http.StatusTooManyRequests, "too_many_requests", "Too many requests")
+	x := upbit.CandlesMinutes(1, "KRW-BTC", time.Time{}, 1)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || x.Common.Attempts != 2 {
+		t.Errorf("TestUpbitRetry | Status:[%d], Attempts:[%d], candlesMinutesErr:[%s]", x.Common.StatusCode, x.Common.Attempts, x.Common.Error)
+	}
+
+	server.FailNext("/v1/orderbook", 5, http.StatusInternalServerError, "internal_server_error", "")
+	y := upbit.Orderbook([]string{"KRW-BTC"})
+	if !errors.Is(y.Common.Error, ErrServerError) || y.Common.Attempts != 3 {
+		t.Errorf("TestUpbitRetry | Status:[%d], Attempts:[%d], orderbookErr:[%s]", y.Common.StatusCode, y.Common.Attempts, y.Common.Error)
+	}
+
+	// 검증 오류 같은 4xx 는 재시도하지 않음
+	z := upbit.Order(OrderOption{Uuid: "does-not-exist"})
+	if !errors.Is(z.Common.Error, ErrOrderNotFound) || z.Common.Attempts != 1 {
+		t.Errorf("TestUpbitRetry | Status:[%d], Attempts:[%d], orderErr:[%s]", z.Common.StatusCode, z.Common.Attempts, z.Common.Error)
+	}
+}
+
+// 주문 재시도 테스트
+//  identifier 가 없으면 재시도하지 않고, 있으면 앞선 시도가 접수됐어도 주문이 하나만 남아야 함
+func TestUpbitRetryPlaceOrder(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}))
+	orders := len(server.Orders())
+
+	server.FailNext("/v1/orders", 1, http.StatusBadGateway, "bad_gateway", "")
+	x := upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Volume: "0.001", Price: "50000000"})
+	if !errors.Is(x.Common.Error, ErrServerError) || x.Common.Attempts != 1 {
+		t.Errorf("TestUpbitRetryPlaceOrder | Status:[%d], Attempts:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Attempts, x.Common.Error)
+	}
+
+	server.FailNext("/v1/orders", 1, http.StatusBadGateway, "bad_gateway", "")
+	x = upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Volume: "0.001", Price: "50000000", Identifier: "retry-1"})
+	if x.Common.Error != nil || x.Common.Attempts != 2 || len(server.Orders()) != orders+1 {
+		t.Errorf("TestUpbitRetryPlaceOrder | Status:[%d], Attempts:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Attempts, x.Common.Error)
+	}
+
+	// 접수된 뒤 응답을 잃어도 identifier 로 접수된 주문을 돌려줘야 함
+	server.LoseNext("/v1/orders", 1, http.StatusGatewayTimeout)
+	y := upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Volume: "0.001", Price: "50000000", Identifier: "retry-2"})
+	if y.Common.Error != nil || y.Common.Attempts < 2 || y.Response.Uuid == "" || len(server.Orders()) != orders+2 {
+		t.Errorf("TestUpbitRetryPlaceOrder | Status:[%d], Attempts:[%d], placeOrderErr:[%s]", y.Common.StatusCode, y.Common.Attempts, y.Common.Error)
+	}
+
+	// 주문 취소는 재시도하지 않음
+	server.FailNext("/v1/order", 1, http.StatusServiceUnavailable, "service_unavailable", "")
+	z := upbit.CancelOrder(OrderOption{Uuid: y.Response.Uuid})
+	if !errors.Is(z.Common.Error, ErrServerError) || z.Common.Attempts != 1 {
+		t.Errorf("TestUpbitRetryPlaceOrder | Status:[%d], Attempts:[%d], cancelOrderErr:[%s]", z.Common.StatusCode, z.Common.Attempts, z.Common.Error)
+	}
+}
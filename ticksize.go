@@ -0,0 +1,110 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"math"
+	"strings"
+)
+
+// 호가 단위 구간
+type tickStep struct {
+	// 구간 시작 가격 (이상)
+	min float64
+	// 호가 단위
+	tick float64
+}
+
+// 원화 마켓 호가 단위
+//  업비트 원화 마켓 호가 정책 기준 (2025-01 확인). 100원 이상 1,000원 미만은 1원, 10원 이상 100원 미만은 0.1원입니다.
+//	정책이 바뀌면 Normalizer.TickSizeFunc 로 덮어쓸 수 있습니다.
+var krwTickSteps = []tickStep{
+	{2000000, 1000},
+	{1000000, 500},
+	{500000, 100},
+	{100000, 50},
+	{10000, 10},
+	{1000, 1},
+	{100, 1},
+	{10, 0.1},
+	{1, 0.01},
+	{0.1, 0.001},
+	{0.01, 0.0001},
+	{0.001, 0.00001},
+	{0.0001, 0.000001},
+	{0.00001, 0.0000001},
+	{0, 0.00000001},
+}
+
+// USDT 마켓 호가 단위
+//  업비트 USDT 마켓 호가 정책 기준 (2025-01 확인)
+var usdtTickSteps = []tickStep{
+	{10, 0.01},
+	{1, 0.001},
+	{0.1, 0.0001},
+	{0.01, 0.00001},
+	{0.001, 0.000001},
+	{0.0001, 0.0000001},
+	{0, 0.00000001},
+}
+
+// 호가 단위 취득
+//  마켓(KRW, BTC, USDT)과 가격에 맞는 주문 가격 단위를 반환합니다.
+//	BTC 마켓과 알 수 없는 마켓은 0.00000001 입니다.
+// Params:
+//	market = 마켓 코드 (ex. KRW-BTC)
+//	price = 주문 가격
+func TickSize(market string, price float64) float64 {
+	var steps []tickStep
+	switch {
+	case strings.HasPrefix(market, "KRW-"):
+		steps = krwTickSteps
+	case strings.HasPrefix(market, "USDT-"):
+		steps = usdtTickSteps
+	default:
+		return 0.00000001
+	}
+	for _, step := range steps {
+		if price >= step.min {
+			return step.tick
+		}
+	}
+	return steps[len(steps)-1].tick
+}
+
+// 호가 단위 취득 (Decimal)
+//  TickSize 와 같지만 가격과 호가 단위를 Decimal 로 다룹니다.
+// Params:
+//	market = 마켓 코드 (ex. KRW-BTC)
+//	price = 주문 가격
+func TickSizeDecimal(market string, price Decimal) Decimal {
+	var steps []tickStep
+	switch {
+	case strings.HasPrefix(market, "KRW-"):
+		steps = krwTickSteps
+	case strings.HasPrefix(market, "USDT-"):
+		steps = usdtTickSteps
+	default:
+		return NewDecimalFromFloat(0.00000001)
+	}
+	for _, step := range steps {
+		if price.Cmp(NewDecimalFromFloat(step.min)) >= 0 {
+			return NewDecimalFromFloat(step.tick)
+		}
+	}
+	return NewDecimalFromFloat(steps[len(steps)-1].tick)
+}
+
+// 호가 단위에 맞는 가격인지 확인
+// Params:
+//	market = 마켓 코드 (ex. KRW-BTC)
+//	price = 주문 가격
+func IsValidTick(market string, price float64) bool {
+	tick := TickSize(market, price)
+	n := price / tick
+	return math.Abs(n-math.Round(n)) < 1e-6
+}
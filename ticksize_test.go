@@ -0,0 +1,38 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"testing"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// 호가 단위 테스트
+func TestTickSize(t *testing.T) {
+	cases := []struct {
+		market string
+		price  float64
+		tick   float64
+	}{
+		{"KRW-BTC", 50000000, 1000},
+		{"KRW-ETH", 1500000, 500},
+		{"KRW-XRP", 500, 1},
+		{"KRW-DOGE", 50, 0.1},
+		{"KRW-SHIB", 0.00005, 0.0000001},
+		{"BTC-ETH", 0.07, 0.00000001},
+		{"USDT-BTC", 20000, 0.01},
+	}
+	for _, c := range cases {
+		if x := TickSize(c.market, c.price); x != c.tick {
+			t.Errorf("TestTickSize | Market:[%s], Price:[%f], Tick:[%f]", c.market, c.price, x)
+		}
+	}
+	if !IsValidTick("KRW-BTC", 50001000) || IsValidTick("KRW-BTC", 50000500) || !IsValidTick("KRW-XRP", 512) || IsValidTick("KRW-XRP", 512.3) || !IsValidTick("KRW-DOGE", 51.2) {
+		t.Errorf("TestTickSize | IsValidTick was wrong")
+	}
+}
@@ -0,0 +1,147 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// 한국 표준시 (UTC+9)
+//  업비트의 *_kst 시각 필드가 이 시간대로 해석됩니다.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// 업비트 시각 문자열 해석
+//  created_at 같은 RFC3339 형식은 문자열의 시간대를, 시간대 없는 형식(ex. candle_date_time_utc)은 loc 을 사용합니다.
+//	빈 문자열은 영값(time.Time{})입니다.
+// Params:
+//	value = 시각 문자열 (ex. 2022-06-01T00:00:00, 2022-06-01T09:00:00+09:00, 2022-06-01)
+//	loc = 시간대 없는 형식의 시간대 (ex. time.UTC, KST)
+func parseTime(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{CANDLE_DATE_TIME_FORMAT, "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("yauga: invalid time %q", value)
+}
+
+// 업비트 시각 필드
+//  JSON 문자열을 parseTime 으로 해석해 dst 에 채웁니다. 시간대 없는 형식은 loc 기준입니다.
+type upbitTime struct {
+	dst *time.Time
+	loc *time.Location
+}
+
+// 업비트 시각 해석
+func (u upbitTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	t, err := parseTime(value, u.loc)
+	if err != nil {
+		return err
+	}
+	*u.dst = t
+	return nil
+}
+
+// 시각 필드가 있는 Block 디코딩
+//  fields 의 JSON 이름은 upbitTime 으로 해석하고, 나머지는 plain(UnmarshalJSON 이 없는 같은 구조의 타입)으로 디코딩합니다.
+// Params:
+//	data = JSON 본문
+//	plain = 디코딩할 곳 (ex. (*plain)(c))
+//	fields = JSON 이름별 시각 필드
+func unmarshalTimes(data []byte, plain interface{}, fields map[string]upbitTime) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for name, field := range fields {
+		if value, ok := raw[name]; ok {
+			if err := field.UnmarshalJSON(value); err != nil {
+				return err
+			}
+			delete(raw, name)
+		}
+	}
+	rest, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(rest, plain)
+}
+
+// 캔들 시각 필드
+//  candle_date_time_utc 는 UTC, candle_date_time_kst 는 KST 로 해석합니다.
+//	firstDayOfPeriod 가 nil 이면 건너뜁니다. 업비트의 기간 첫 날은 한국 날짜이므로 KST 로 해석합니다.
+func candleTimes(utc *time.Time, kst *time.Time, firstDayOfPeriod *time.Time) map[string]upbitTime {
+	fields := map[string]upbitTime{
+		"candle_date_time_utc": {dst: utc, loc: time.UTC},
+		"candle_date_time_kst": {dst: kst, loc: KST},
+	}
+	if firstDayOfPeriod != nil {
+		fields["first_day_of_period"] = upbitTime{dst: firstDayOfPeriod, loc: KST}
+	}
+	return fields
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *Candle) UnmarshalJSON(data []byte) error {
+	type plain Candle
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, &c.FirstDayOfPeriod))
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *UpbitCandlesSecondsBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitCandlesSecondsBlock
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, nil))
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *UpbitCandlesMinutesBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitCandlesMinutesBlock
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, nil))
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *UpbitCandlesDaysBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitCandlesDaysBlock
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, nil))
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *UpbitCandlesWeeksBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitCandlesWeeksBlock
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, &c.FirstDayOfPeriod))
+}
+
+// 시간대 없는 캔들 시각을 UTC, KST 로 해석
+func (c *UpbitCandlesMonthsBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitCandlesMonthsBlock
+	return unmarshalTimes(data, (*plain)(c), candleTimes(&c.CandleDateTimeUtc, &c.CandleDateTimeKst, &c.FirstDayOfPeriod))
+}
+
+// 주문 생성 시간 해석
+func (b *UpbitOrderBlock) UnmarshalJSON(data []byte) error {
+	type plain UpbitOrderBlock
+	return unmarshalTimes(data, (*plain)(b), map[string]upbitTime{"created_at": {dst: &b.CreatedAt, loc: KST}})
+}
+
+// 체결 시각 해석
+func (b *TradeBlock) UnmarshalJSON(data []byte) error {
+	type plain TradeBlock
+	return unmarshalTimes(data, (*plain)(b), map[string]upbitTime{"created_at": {dst: &b.CreatedAt, loc: KST}})
+}
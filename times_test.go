@@ -0,0 +1,61 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// 시각 필드 테스트
+//  업비트 응답의 시간대 없는 캔들 시각, 주문 생성 시간이 올바른 시간대로 해석되어야 함
+func TestUpbitTimes(t *testing.T) {
+	var minutes []UpbitCandlesMinutesBlock
+	if err := json.Unmarshal([]byte(`[{"market":"KRW-BTC","candle_date_time_utc":"2022-06-01T00:01:00","candle_date_time_kst":"2022-06-01T09:01:00","unit":1}]`), &minutes); err != nil {
+		t.Fatalf("TestUpbitTimes | unmarshalErr:[%s]", err)
+	}
+	utc := time.Date(2022, 6, 1, 0, 1, 0, 0, time.UTC)
+	if !minutes[0].CandleDateTimeUtc.Equal(utc) || !minutes[0].CandleDateTimeKst.Equal(utc) || minutes[0].CandleDateTimeKst.Location() != KST || minutes[0].Unit != 1 {
+		t.Errorf("TestUpbitTimes | UTC:[%s], KST:[%s]", minutes[0].CandleDateTimeUtc, minutes[0].CandleDateTimeKst)
+	}
+
+	var weeks []UpbitCandlesWeeksBlock
+	if err := json.Unmarshal([]byte(`[{"market":"KRW-BTC","candle_date_time_utc":"2022-05-30T00:00:00","candle_date_time_kst":"2022-05-30T09:00:00","first_day_of_period":"2022-05-30"}]`), &weeks); err != nil {
+		t.Fatalf("TestUpbitTimes | unmarshalErr:[%s]", err)
+	}
+	if !weeks[0].FirstDayOfPeriod.Equal(time.Date(2022, 5, 30, 0, 0, 0, 0, KST)) || weeks[0].FirstDayOfPeriod.Location() != KST {
+		t.Errorf("TestUpbitTimes | FirstDayOfPeriod:[%s]", weeks[0].FirstDayOfPeriod)
+	}
+
+	var order UpbitOrderBlock
+	if err := json.Unmarshal([]byte(`{"uuid":"u-1","price":"50000000","created_at":"2022-06-01T09:00:00+09:00","trades":[{"uuid":"t-1","created_at":"2022-06-01T09:00:01+09:00"}]}`), &order); err != nil {
+		t.Fatalf("TestUpbitTimes | unmarshalErr:[%s]", err)
+	}
+	if !order.CreatedAt.Equal(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)) || order.Price.String() != "50000000" || !order.Trades[0].CreatedAt.Equal(time.Date(2022, 6, 1, 0, 0, 1, 0, time.UTC)) {
+		t.Errorf("TestUpbitTimes | CreatedAt:[%s], Price:[%s]", order.CreatedAt, order.Price)
+	}
+	if err := json.Unmarshal([]byte(`{"created_at":"yesterday"}`), &order); err == nil {
+		t.Errorf("TestUpbitTimes | invalid created_at was accepted")
+	}
+
+	// to 는 UTC 로 보내야 함
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesMinutes(1, "KRW-BTC", testNow.Add(-time.Hour).In(KST), 1)
+	if x.Common.Error != nil || len(x.Response) != 1 || !x.Response[0].CandleDateTimeUtc.Equal(testNow.Add(-time.Hour-time.Minute)) {
+		t.Errorf("TestUpbitTimes | Status:[%d], candlesMinutesErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	requests := server.Requests()
+	if last := requests[len(requests)-1]; !strings.Contains(last, "to=2022-05-31T23%3A00%3A00Z") {
+		t.Errorf("TestUpbitTimes | Request:[%s]", last)
+	}
+}
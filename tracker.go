@@ -0,0 +1,364 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 주문 추적 이벤트 종류
+type OrderEventType string
+
+const (
+	// 주문 상태 변경 (ex. 처음 확인한 wait, watch -> wait)
+	ORDER_EVENT_STATE OrderEventType = "state"
+	// 새 체결 (부분 체결 포함)
+	ORDER_EVENT_TRADE OrderEventType = "trade"
+	// 주문 종료 (done, cancel, prevented)
+	ORDER_EVENT_DONE OrderEventType = "done"
+	// 주문 조회 실패 (Error)
+	ORDER_EVENT_ERROR OrderEventType = "error"
+)
+
+// 주문 추적 이벤트
+type OrderEvent struct {
+	// 이벤트 종류
+	Type OrderEventType
+	// 이벤트 시점의 주문
+	Order TrackedOrder
+	// 바뀌기 전 상태 (처음 확인한 주문이면 "")
+	Previous OrderState
+	// 새 체결 (ORDER_EVENT_TRADE 일 때)
+	Trades []TradeBlock
+	// 조회 오류 (ORDER_EVENT_ERROR 일 때)
+	Error error
+}
+
+// 추적 중인 주문
+//  Order.Trades 대신 지금까지 받은 체결을 모두 모은 Trades 를 사용하세요.
+type TrackedOrder struct {
+	// 마지막으로 받은 주문
+	Order UpbitOrderBlock
+	// 지금까지 받은 체결 (체결 시각 순)
+	Trades []TradeBlock
+}
+
+// 주문 상태
+func (o TrackedOrder) State() OrderState {
+	return OrderState(o.Order.State)
+}
+
+// 주문이 끝났는지 확인 (done, cancel, prevented)
+func (o TrackedOrder) Done() bool {
+	return isTerminalState(o.State())
+}
+
+// 체결된 양 (체결 합계)
+func (o TrackedOrder) ExecutedVolume() Decimal {
+	volume := NewDecimalFromInt(0)
+	for _, trade := range o.Trades {
+		volume = volume.Add(trade.Volume)
+	}
+	return volume
+}
+
+// 체결된 총 금액 (체결 합계)
+func (o TrackedOrder) ExecutedFunds() Decimal {
+	funds := NewDecimalFromInt(0)
+	for _, trade := range o.Trades {
+		funds = funds.Add(trade.Funds)
+	}
+	return funds
+}
+
+// 평균 체결 가격
+//  체결 금액 합 / 체결 양 합을 소수점 8자리에서 반올림합니다. 체결이 없으면 0 입니다.
+func (o TrackedOrder) AvgPrice() Decimal {
+	avg, err := o.ExecutedFunds().Div(o.ExecutedVolume(), VOLUME_PRECISION, ROUND_HALF_UP)
+	if err != nil {
+		return NewDecimalFromInt(0)
+	}
+	return avg
+}
+
+// 사용된 수수료
+func (o TrackedOrder) PaidFee() Decimal {
+	return o.Order.PaidFee
+}
+
+// 주문 추적기
+//  주문 상태(wait, watch, done, cancel, prevented)와 체결을 따라가며 바뀔 때마다 OnEvent 로 등록한 함수를 부릅니다.
+//	Poll 로 주문을 주기적으로 조회하거나, 내 주문 웹소켓(myOrder)으로 받은 주문을 Apply 로 넣을 수 있습니다.
+//	둘을 함께 써도 체결은 고유 아이디로 한 번만 알립니다.
+type OrderTracker struct {
+	exchange Exchange
+
+	mu       sync.Mutex
+	orders   map[string]*TrackedOrder
+	seen     map[string]map[string]bool
+	handlers []func(event OrderEvent)
+	emitMu   sync.Mutex
+}
+
+// Initialization
+// Params:
+//	exchange = 주문을 조회할 구현 (ex. *Upbit, paper.NewExchange(...))
+func NewOrderTracker(exchange Exchange) *OrderTracker {
+	return &OrderTracker{exchange: exchange, orders: map[string]*TrackedOrder{}, seen: map[string]map[string]bool{}}
+}
+
+// 이벤트 받을 함수 등록
+//  이벤트는 한 번에 하나씩 일어난 순서대로 전달됩니다.
+//	함수 안에서 Order, Orders, Untrack 은 불러도 되지만 Apply, Track, Refresh 를 부르면 멈춥니다.
+func (t *OrderTracker) OnEvent(fn func(event OrderEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers = append(t.handlers, fn)
+}
+
+// 주문 추적 시작
+//  주문하기 결과를 그대로 넘기거나 UpbitOrderBlock{Uuid: uuid} 처럼 고유 아이디만 넘길 수 있습니다.
+//	상태가 있으면 Apply 와 같이 반영합니다.
+// Params:
+//	order = 주문 (Uuid 필수)
+func (t *OrderTracker) Track(order UpbitOrderBlock) {
+	if order.Uuid == "" {
+		return
+	}
+	t.mu.Lock()
+	if _, ok := t.orders[order.Uuid]; !ok {
+		t.orders[order.Uuid] = &TrackedOrder{Order: UpbitOrderBlock{Uuid: order.Uuid}}
+		t.seen[order.Uuid] = map[string]bool{}
+	}
+	t.mu.Unlock()
+	if order.State != "" {
+		t.Apply(order)
+	}
+}
+
+// 주문 추적 그만두기
+func (t *OrderTracker) Untrack(uuid string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.orders, uuid)
+	delete(t.seen, uuid)
+}
+
+// 추적 중인 주문 취득
+func (t *OrderTracker) Order(uuid string) (TrackedOrder, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked, ok := t.orders[uuid]
+	if !ok {
+		return TrackedOrder{}, false
+	}
+	return tracked.copy(), true
+}
+
+// 추적 중인 주문 전체 취득 (주문 생성 시각 순)
+func (t *OrderTracker) Orders() []TrackedOrder {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	orders := make([]TrackedOrder, 0, len(t.orders))
+	for _, tracked := range t.orders {
+		orders = append(orders, tracked.copy())
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Order.CreatedAt.Before(orders[j].Order.CreatedAt)
+	})
+	return orders
+}
+
+// 주문 반영
+//  새 체결, 상태 변경, 종료 순으로 이벤트를 보냅니다. 추적하지 않는 주문은 무시합니다.
+//	끝난 주문(done, cancel, prevented)은 다시 진행 중 상태로 돌아가지 않습니다. (늦게 도착한 조회 결과)
+//	비어 있거나 예전 값은 이전 값을 덮지 않습니다. (ex. 가격이 빠진 웹소켓 체결 메시지)
+// Params:
+//	order = 조회하거나 웹소켓으로 받은 주문
+func (t *OrderTracker) Apply(order UpbitOrderBlock) {
+	t.mu.Lock()
+	tracked, ok := t.orders[order.Uuid]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+	previous := tracked.State()
+	state := OrderState(order.State)
+	if state == "" || (isTerminalState(previous) && !isTerminalState(state)) {
+		state = previous
+	}
+
+	seen := t.seen[order.Uuid]
+	trades := []TradeBlock{}
+	for _, trade := range order.Trades {
+		if trade.Uuid == "" || seen[trade.Uuid] {
+			continue
+		}
+		seen[trade.Uuid] = true
+		trades = append(trades, trade)
+	}
+	tracked.Trades = append(tracked.Trades, trades...)
+	sort.SliceStable(tracked.Trades, func(i, j int) bool {
+		return tracked.Trades[i].CreatedAt.Before(tracked.Trades[j].CreatedAt)
+	})
+	tracked.merge(order)
+	tracked.Order.State = string(state)
+
+	events := []OrderEvent{}
+	snapshot := tracked.copy()
+	if len(trades) > 0 {
+		events = append(events, OrderEvent{Type: ORDER_EVENT_TRADE, Order: snapshot, Previous: previous, Trades: trades})
+	}
+	if state != previous {
+		eventType := ORDER_EVENT_STATE
+		if isTerminalState(state) {
+			eventType = ORDER_EVENT_DONE
+		}
+		events = append(events, OrderEvent{Type: eventType, Order: snapshot, Previous: previous})
+	}
+	handlers := append([]func(event OrderEvent){}, t.handlers...)
+
+	// 이벤트 순서를 지키기 위해 mu 를 놓기 전에 emitMu 를 잡음
+	t.emitMu.Lock()
+	t.mu.Unlock()
+	defer t.emitMu.Unlock()
+	for _, event := range events {
+		for _, fn := range handlers {
+			fn(event)
+		}
+	}
+}
+
+// 끝나지 않은 주문 한 번 조회
+//  조회에 성공한 주문은 Apply 로 반영하고, 실패한 주문마다 ORDER_EVENT_ERROR 를 보낸 뒤 마지막 실패를 반환합니다.
+// Params:
+//	ctx = 요청 context
+func (t *OrderTracker) Refresh(ctx context.Context) UpbitCommonBlock {
+	t.mu.Lock()
+	pending := []string{}
+	for uuid, tracked := range t.orders {
+		if !tracked.Done() {
+			pending = append(pending, uuid)
+		}
+	}
+	t.mu.Unlock()
+	sort.Strings(pending)
+
+	var common UpbitCommonBlock
+	for _, uuid := range pending {
+		res := t.exchange.OrderContext(ctx, OrderOption{Uuid: uuid})
+		if res.Common.Error != nil {
+			common = res.Common
+			if ctx.Err() == nil {
+				t.fail(uuid, res.Common.Error)
+			}
+			continue
+		}
+		if common.Error == nil {
+			common = res.Common
+		}
+		t.Apply(res.Response)
+	}
+	return common
+}
+
+// 주문 따라가기
+//  interval 마다 끝나지 않은 주문을 조회해 반영합니다.
+//	조회에 실패한 주문은 ORDER_EVENT_ERROR 로 알리고 계속 조회하며, ctx 가 끝나야 멈춥니다.
+// Params:
+//	ctx = 중지용 context
+//	interval = 조회 주기
+func (t *OrderTracker) Poll(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		t.Refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// 조회 실패 알리기
+func (t *OrderTracker) fail(uuid string, err error) {
+	t.mu.Lock()
+	tracked, ok := t.orders[uuid]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+	event := OrderEvent{Type: ORDER_EVENT_ERROR, Order: tracked.copy(), Previous: tracked.State(), Error: err}
+	handlers := append([]func(event OrderEvent){}, t.handlers...)
+
+	t.emitMu.Lock()
+	t.mu.Unlock()
+	defer t.emitMu.Unlock()
+	for _, fn := range handlers {
+		fn(event)
+	}
+}
+
+// 복사본 (체결 목록 공유 방지)
+func (o *TrackedOrder) copy() TrackedOrder {
+	return TrackedOrder{Order: o.Order, Trades: append([]TradeBlock{}, o.Trades...)}
+}
+
+// 받은 주문 합치기
+//  빈 값(0, "")은 이전 값을 유지하고, 체결된 양, 사용된 수수료, 체결 수는 줄어들지 않습니다.
+//	체결된 양이 이전보다 적은 주문은 예전 조회 결과로 보고 잔량, 묶인 금액도 이전 값을 유지합니다.
+func (o *TrackedOrder) merge(order UpbitOrderBlock) {
+	current := o.Order
+	stale := order.ExecutedVolume.LessThan(current.ExecutedVolume)
+	keep := func(v *Decimal, previous Decimal) {
+		if stale || (v.IsZero() && !isTerminalState(OrderState(order.State))) {
+			*v = previous
+		}
+	}
+
+	if order.Side == "" {
+		order.Side = current.Side
+	}
+	if order.OrdType == "" {
+		order.OrdType = current.OrdType
+	}
+	if order.Market == "" {
+		order.Market = current.Market
+	}
+	if order.CreatedAt.IsZero() {
+		order.CreatedAt = current.CreatedAt
+	}
+	if order.Price.IsZero() {
+		order.Price = current.Price
+	}
+	if order.Volume.IsZero() {
+		order.Volume = current.Volume
+	}
+	keep(&order.RemainingVolume, current.RemainingVolume)
+	keep(&order.ReservedFee, current.ReservedFee)
+	keep(&order.RemainingFee, current.RemainingFee)
+	keep(&order.Locked, current.Locked)
+	order.ExecutedVolume = MaxDecimal(order.ExecutedVolume, current.ExecutedVolume)
+	order.PaidFee = MaxDecimal(order.PaidFee, current.PaidFee)
+	if order.TradeCount < current.TradeCount {
+		order.TradeCount = current.TradeCount
+	}
+	if len(order.Trades) <= 0 {
+		order.Trades = current.Trades
+	}
+	o.Order = order
+}
+
+// 끝난 주문 상태인지 확인
+func isTerminalState(state OrderState) bool {
+	return state == ORDER_STATE_DONE || state == ORDER_STATE_CANCEL || state == ORDER_STATE_PREVENTED
+}
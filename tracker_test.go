@@ -0,0 +1,152 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// OrderTracker 테스트
+//  상태 변경, 부분 체결, 종료를 한 번씩 알리고 평균 체결 가격과 수수료를 계산해야 함
+func TestUpbitOrderTracker(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	placed := upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50000000", Volume: "0.002"})
+	if placed.Common.Error != nil {
+		t.Fatalf("TestUpbitOrderTracker | placeOrderErr:[%s]", placed.Common.Error)
+	}
+	uuid := placed.Response.Uuid
+
+	tracker := NewOrderTracker(upbit)
+	events := []OrderEvent{}
+	tracker.OnEvent(func(event OrderEvent) {
+		events = append(events, event)
+	})
+	tracker.Track(UpbitOrderBlock{Uuid: uuid})
+	if x := tracker.Refresh(context.Background()); x.Error != nil || len(events) != 1 || events[0].Type != ORDER_EVENT_STATE || events[0].Previous != "" || events[0].Order.State() != ORDER_STATE_WAIT {
+		t.Fatalf("TestUpbitOrderTracker | Events:[%v], refreshErr:[%s]", events, x.Error)
+	}
+
+	// 부분 체결
+	server.UpdateOrder(uuid, func(order *UpbitOrderBlock) {
+		order.Trades = append(order.Trades, TradeBlock{Market: "KRW-BTC", Uuid: "trade-1", Price: MustDecimal("49000000"), Volume: MustDecimal("0.001"), Funds: MustDecimal("49000"), Side: "bid", CreatedAt: testNow})
+		order.ExecutedVolume = MustDecimal("0.001")
+		order.PaidFee = MustDecimal("24.5")
+	})
+	tracker.Refresh(context.Background())
+	tracker.Refresh(context.Background())
+	if len(events) != 2 || events[1].Type != ORDER_EVENT_TRADE || len(events[1].Trades) != 1 || events[1].Order.Done() {
+		t.Fatalf("TestUpbitOrderTracker | Events:[%v]", events)
+	}
+
+	// 가격이 빠진 웹소켓 체결 메시지는 주문 가격을 지우지 않음
+	tracker.Apply(UpbitOrderBlock{Uuid: uuid, State: string(ORDER_STATE_WAIT), Volume: MustDecimal("0.002"), RemainingVolume: MustDecimal("0.001"), ExecutedVolume: MustDecimal("0.001"), PaidFee: MustDecimal("24.5"),
+		Trades: []TradeBlock{{Market: "KRW-BTC", Uuid: "trade-1", Price: MustDecimal("49000000"), Volume: MustDecimal("0.001"), Funds: MustDecimal("49000"), Side: "bid", CreatedAt: testNow}}})
+	if x, _ := tracker.Order(uuid); len(events) != 2 || !x.Order.Price.Equal(MustDecimal("50000000")) || x.Order.Market != "KRW-BTC" || x.Order.CreatedAt.IsZero() {
+		t.Errorf("TestUpbitOrderTracker | Events:[%d], Price:[%s], Market:[%s]", len(events), x.Order.Price, x.Order.Market)
+	}
+
+	// 나머지 체결과 종료
+	server.UpdateOrder(uuid, func(order *UpbitOrderBlock) {
+		order.Trades = append(order.Trades, TradeBlock{Market: "KRW-BTC", Uuid: "trade-2", Price: MustDecimal("50000000"), Volume: MustDecimal("0.001"), Funds: MustDecimal("50000"), Side: "bid", CreatedAt: testNow.Add(time.Second)})
+		order.ExecutedVolume = MustDecimal("0.002")
+		order.PaidFee = MustDecimal("49.5")
+		order.State = string(ORDER_STATE_DONE)
+	})
+	tracker.Refresh(context.Background())
+	if len(events) != 4 || events[2].Type != ORDER_EVENT_TRADE || events[2].Trades[0].Uuid != "trade-2" || events[3].Type != ORDER_EVENT_DONE || events[3].Previous != ORDER_STATE_WAIT {
+		t.Fatalf("TestUpbitOrderTracker | Events:[%v]", events)
+	}
+
+	x, ok := tracker.Order(uuid)
+	if !ok || !x.Done() || len(x.Trades) != 2 || x.AvgPrice().String() != "49500000.00000000" || !x.PaidFee().Equal(MustDecimal("49.5")) || !x.ExecutedVolume().Equal(MustDecimal("0.002")) {
+		t.Errorf("TestUpbitOrderTracker | Done:[%t], Trades:[%d], AvgPrice:[%s], PaidFee:[%s]", x.Done(), len(x.Trades), x.AvgPrice(), x.PaidFee())
+	}
+
+	// 늦게 도착한 진행 중 상태는 무시
+	tracker.Apply(UpbitOrderBlock{Uuid: uuid, State: string(ORDER_STATE_WAIT)})
+	if x, _ = tracker.Order(uuid); x.State() != ORDER_STATE_DONE || len(events) != 4 {
+		t.Errorf("TestUpbitOrderTracker | State:[%s], Events:[%d]", x.State(), len(events))
+	}
+	if !x.PaidFee().Equal(MustDecimal("49.5")) || !x.Order.ExecutedVolume.Equal(MustDecimal("0.002")) || !x.Order.Price.Equal(MustDecimal("50000000")) || !x.Order.Volume.Equal(MustDecimal("0.002")) {
+		t.Errorf("TestUpbitOrderTracker | PaidFee:[%s], ExecutedVolume:[%s], Price:[%s], Volume:[%s]", x.PaidFee(), x.Order.ExecutedVolume, x.Order.Price, x.Order.Volume)
+	}
+
+	// 끝난 주문은 다시 조회하지 않음
+	requests := len(server.Requests())
+	tracker.Refresh(context.Background())
+	if len(server.Requests()) != requests {
+		t.Errorf("TestUpbitOrderTracker | Requests:[%d]", len(server.Requests())-requests)
+	}
+	tracker.Untrack(uuid)
+	if _, ok = tracker.Order(uuid); ok || len(tracker.Orders()) != 0 {
+		t.Errorf("TestUpbitOrderTracker | Untrack:[%t]", ok)
+	}
+}
+
+// OrderTracker prevented 테스트
+//  자전거래 체결 방지로 취소된 주문은 끝난 주문으로 보고 다시 조회하지 않아야 함
+func TestUpbitOrderTrackerPrevented(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	placed := upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50000000", Volume: "0.002"})
+	if placed.Common.Error != nil {
+		t.Fatalf("TestUpbitOrderTrackerPrevented | placeOrderErr:[%s]", placed.Common.Error)
+	}
+	uuid := placed.Response.Uuid
+	server.UpdateOrder(uuid, func(order *UpbitOrderBlock) {
+		order.State = string(ORDER_STATE_PREVENTED)
+	})
+
+	tracker := NewOrderTracker(upbit)
+	events := []OrderEvent{}
+	tracker.OnEvent(func(event OrderEvent) {
+		events = append(events, event)
+	})
+	tracker.Track(UpbitOrderBlock{Uuid: uuid})
+	tracker.Refresh(context.Background())
+	if len(events) != 1 || events[0].Type != ORDER_EVENT_DONE || !events[0].Order.Done() {
+		t.Fatalf("TestUpbitOrderTrackerPrevented | Events:[%v]", events)
+	}
+
+	requests := len(server.Requests())
+	tracker.Refresh(context.Background())
+	if len(server.Requests()) != requests {
+		t.Errorf("TestUpbitOrderTrackerPrevented | Requests:[%d]", len(server.Requests())-requests)
+	}
+}
+
+// OrderTracker.Poll 테스트
+//  조회에 실패해도 ORDER_EVENT_ERROR 로 알리고 ctx 가 끝날 때까지 계속 조회해야 함
+func TestUpbitOrderTrackerPoll(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	tracker := NewOrderTracker(server.NewUpbit(WithRetryPolicy(RetryPolicy{MaxAttempts: 1})))
+	tracker.Track(UpbitOrderBlock{Uuid: "not-exist"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	failures := 0
+	tracker.OnEvent(func(event OrderEvent) {
+		if event.Type != ORDER_EVENT_ERROR || event.Order.Order.Uuid != "not-exist" || event.Error == nil {
+			t.Errorf("TestUpbitOrderTrackerPoll | Type:[%s], Uuid:[%s], eventErr:[%v]", event.Type, event.Order.Order.Uuid, event.Error)
+		}
+		if failures++; failures == 3 {
+			cancel()
+		}
+	})
+	if err := tracker.Poll(ctx, 10*time.Millisecond); !errors.Is(err, context.Canceled) || failures < 3 {
+		t.Errorf("TestUpbitOrderTrackerPoll | Failures:[%d], pollErr:[%v]", failures, err)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package yauga
 
 /**
  * yauga -  Yet another Upbit API for golang / LGPL-v2.1
@@ -7,45 +7,225 @@ package main
  * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
  */
 import (
+	"bytes"
+	"context"
 	"crypto/sha512"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 )
 
 const (
+	// 업비트 API 호스트
+	UPBIT_HOST = "https://api.upbit.com"
+	// 업비트 웹소켓 호스트
+	UPBIT_WEBSOCKET_HOST = "wss://api.upbit.com"
+	// 업비트 싱가포르 API 호스트
+	UPBIT_HOST_SG = "https://sg-api.upbit.com"
+	// 업비트 인도네시아 API 호스트
+	UPBIT_HOST_ID = "https://id-api.upbit.com"
+	// 업비트 태국 API 호스트
+	UPBIT_HOST_TH = "https://th-api.upbit.com"
+
 	// [Exchange API] 전체 계좌 조회 (Full account inquiry)
-	UPBIT_URL_ACCOUNTS = "https://api.upbit.com/v1/accounts"
+	UPBIT_URL_ACCOUNTS = UPBIT_HOST + "/v1/accounts"
 	// [Exchange API] 주문 가능 정보
-	UPBIT_URL_ORDERS_CHANCE = "https://api.upbit.com/v1/orders/chance"
-	// [Exchange API] 개별 주문 조회
-	UPBIT_URL_ORDER = "https://api.upbit.com/v1/order"
+	UPBIT_URL_ORDERS_CHANCE = UPBIT_HOST + "/v1/orders/chance"
+	// [Exchange API] 개별 주문 조회, 주문 취소 접수
+	UPBIT_URL_ORDER = UPBIT_HOST + "/v1/order"
+	// [Exchange API] 주문하기, 주문 리스트 조회
+	UPBIT_URL_ORDERS = UPBIT_HOST + "/v1/orders"
 
 	// [Quotation API] 마켓 코드 조회 (Market code inquiry)
-	UPBIT_URL_MARKET_ALL = "https://api.upbit.com/v1/market/all"
+	UPBIT_URL_MARKET_ALL = UPBIT_HOST + "/v1/market/all"
+	// [Quotation API] 캔들 (Candles inquiry). 뒤에 캔들 단위(Interval)를 붙여 사용
+	UPBIT_URL_CANDLES = UPBIT_HOST + "/v1/candles/"
+	// [Quotation API] 초(Second) 캔들 (Seconds candles inquiry)
+	UPBIT_URL_CANDLES_SECONDS = UPBIT_HOST + "/v1/candles/seconds"
 	// [Quotation API] 분(Minute) 캔들 (Minutes candles inquiry)
-	UPBIT_URL_CANDLES_MINUTES = "https://api.upbit.com/v1/candles/minutes/%d"
+	UPBIT_URL_CANDLES_MINUTES = UPBIT_HOST + "/v1/candles/minutes/%d"
 	// [Quotation API] 일(Day) 캔들 (Days candles inquiry)
-	UPBIT_URL_CANDLES_DAYS = "https://api.upbit.com/v1/candles/days"
+	UPBIT_URL_CANDLES_DAYS = UPBIT_HOST + "/v1/candles/days"
 	// [Quotation API] 주(Week) 캔들 (Weeks candles inquiry)
-	UPBIT_URL_CANDLES_WEEKS = "https://api.upbit.com/v1/candles/weeks"
+	UPBIT_URL_CANDLES_WEEKS = UPBIT_HOST + "/v1/candles/weeks"
+	// [Quotation API] 월(Month) 캔들 (Months candles inquiry)
+	UPBIT_URL_CANDLES_MONTHS = UPBIT_HOST + "/v1/candles/months"
+	// [Quotation API] 최근 체결 내역 (Recent trades inquiry)
+	UPBIT_URL_TRADES_TICKS = UPBIT_HOST + "/v1/trades/ticks"
+	// [Quotation API] 현재가 정보 (Ticker inquiry)
+	UPBIT_URL_TICKER = UPBIT_HOST + "/v1/ticker"
+	// [Quotation API] 호가 정보 조회 (Orderbook inquiry)
+	UPBIT_URL_ORDERBOOK = UPBIT_HOST + "/v1/orderbook"
+)
+
+// 주문 종류
+type OrderSide string
+
+const (
+	// 매수
+	ORDER_SIDE_BID OrderSide = "bid"
+	// 매도
+	ORDER_SIDE_ASK OrderSide = "ask"
 )
 
+// 주문 방식
+type OrderType string
+
+const (
+	// 지정가 주문
+	ORDER_TYPE_LIMIT OrderType = "limit"
+	// 시장가 주문(매수)
+	ORDER_TYPE_PRICE OrderType = "price"
+	// 시장가 주문(매도)
+	ORDER_TYPE_MARKET OrderType = "market"
+	// 최유리 주문
+	ORDER_TYPE_BEST OrderType = "best"
+)
+
+// 주문 상태
+type OrderState string
+
+const (
+	// 체결 대기
+	ORDER_STATE_WAIT OrderState = "wait"
+	// 예약주문 대기
+	ORDER_STATE_WATCH OrderState = "watch"
+	// 전체 체결 완료
+	ORDER_STATE_DONE OrderState = "done"
+	// 주문 취소
+	ORDER_STATE_CANCEL OrderState = "cancel"
+	// 자전거래 체결 방지로 취소
+	ORDER_STATE_PREVENTED OrderState = "prevented"
+)
+
+// 주문 체결 조건
+type TimeInForce string
+
+const (
+	// 지정가 조건 없음
+	TIME_IN_FORCE_NONE TimeInForce = ""
+	// Immediate or Cancel
+	TIME_IN_FORCE_IOC TimeInForce = "ioc"
+	// Fill or Kill
+	TIME_IN_FORCE_FOK TimeInForce = "fok"
+)
+
+// 캔들 단위
+//  캔들 URL 뒤에 붙는 경로와 같습니다.
+type Interval string
+
+const (
+	// 초(Second) 캔들
+	INTERVAL_SECOND Interval = "seconds"
+	// 1분 캔들
+	INTERVAL_MINUTE_1 Interval = "minutes/1"
+	// 3분 캔들
+	INTERVAL_MINUTE_3 Interval = "minutes/3"
+	// 5분 캔들
+	INTERVAL_MINUTE_5 Interval = "minutes/5"
+	// 10분 캔들
+	INTERVAL_MINUTE_10 Interval = "minutes/10"
+	// 15분 캔들
+	INTERVAL_MINUTE_15 Interval = "minutes/15"
+	// 30분 캔들
+	INTERVAL_MINUTE_30 Interval = "minutes/30"
+	// 60분 캔들
+	INTERVAL_MINUTE_60 Interval = "minutes/60"
+	// 240분 캔들
+	INTERVAL_MINUTE_240 Interval = "minutes/240"
+	// 일(Day) 캔들
+	INTERVAL_DAY Interval = "days"
+	// 주(Week) 캔들
+	INTERVAL_WEEK Interval = "weeks"
+	// 월(Month) 캔들
+	INTERVAL_MONTH Interval = "months"
+)
+
+// 지원하는 캔들 단위인지 확인
+func (i Interval) valid() bool {
+	switch i {
+	case INTERVAL_SECOND, INTERVAL_MINUTE_1, INTERVAL_MINUTE_3, INTERVAL_MINUTE_5,
+		INTERVAL_MINUTE_10, INTERVAL_MINUTE_15, INTERVAL_MINUTE_30, INTERVAL_MINUTE_60,
+		INTERVAL_MINUTE_240, INTERVAL_DAY, INTERVAL_WEEK, INTERVAL_MONTH:
+		return true
+	}
+	return false
+}
+
 type Upbit struct {
 	AccessKey        string
 	token, secretKey string
+	// token 동시 접근 보호
+	mu sync.Mutex
+
+	httpClient  *http.Client
+	baseUrl     string
+	userAgent   string
+	rateLimiter *RateLimiter
+	retryPolicy RetryPolicy
+}
+
+// NewUpbit 옵션
+type ClientOption func(*Upbit)
+
+// HTTP 클라이언트 지정 (기본값 : http.DefaultClient)
+//  프록시, 타임아웃 등은 클라이언트에 설정하세요.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(o *Upbit) {
+		o.httpClient = client
+	}
+}
+
+// API 호스트 지정 (기본값 : UPBIT_HOST)
+//  ex. UPBIT_HOST_SG, httptest.Server 의 URL
+func WithBaseURL(baseUrl string) ClientOption {
+	return func(o *Upbit) {
+		o.SetBaseURL(baseUrl)
+	}
+}
+
+// User-Agent 헤더 지정
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *Upbit) {
+		o.userAgent = userAgent
+	}
+}
+
+// 요청 수 제한기 지정 (기본값 : NewRateLimiter())
+//  여러 Upbit 이 같은 키를 쓰면 같은 제한기를 나눠 쓰세요. nil 이면 요청 수를 제한하지 않습니다.
+func WithRateLimiter(rateLimiter *RateLimiter) ClientOption {
+	return func(o *Upbit) {
+		o.rateLimiter = rateLimiter
+	}
+}
+
+// 재시도 정책 지정 (기본값 : DefaultRetryPolicy())
+//  재시도하지 않으려면 RetryPolicy{MaxAttempts: 1} 을 넘기세요.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(o *Upbit) {
+		o.retryPolicy = policy
+	}
 }
 
 // Initialization
-func NewUpbit(accessKey string) *Upbit {
-	return &Upbit{AccessKey: accessKey}
+func NewUpbit(accessKey string, opts ...ClientOption) *Upbit {
+	o := &Upbit{AccessKey: accessKey, httpClient: http.DefaultClient, baseUrl: UPBIT_HOST, rateLimiter: NewRateLimiter(), retryPolicy: DefaultRetryPolicy()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
 }
 
 /*type NewUpbitRequest struct {
@@ -64,8 +244,30 @@ func (o *Upbit) SetSecretKey(secretKey string) {
 	o.secretKey = secretKey
 }
 
+// API 호스트 세팅
+//  REST, 웹소켓 모든 엔드포인트가 이 호스트 기준으로 만들어집니다.
+//	요청을 보내기 전에 세팅하세요.
+// Params:
+//	baseUrl = API 호스트 (ex. UPBIT_HOST_SG, http://127.0.0.1:8080)
+func (o *Upbit) SetBaseURL(baseUrl string) {
+	o.baseUrl = strings.TrimSuffix(baseUrl, "/")
+}
+
+// API 호스트 취득
+func (o *Upbit) BaseURL() string {
+	return o.baseUrl
+}
+
+// 요청 수 제한기 취득
+//  그룹별 남은 요청 수를 확인할 수 있습니다. 제한하지 않으면 nil 입니다.
+func (o *Upbit) RateLimiter() *RateLimiter {
+	return o.rateLimiter
+}
+
 // 토큰 취득
 func (o *Upbit) GetToken() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	return o.token
 }
 
@@ -77,7 +279,14 @@ type PayloadOption struct {
 // 인증 가능한 요청 만들기
 //  서명 방식은 HS256 을 권장하며, 서명에 사용할 secret은 발급받은 secret key를 사용합니다.
 //  페이로드의 구성은 다음과 같습니다.
-func (o *Upbit) payload(opt PayloadOption) (string, error) {
+//  반환한 토큰("Bearer ...")은 웹소켓 등 직접 만드는 요청의 Authorization 헤더에 사용할 수 있습니다.
+func (o *Upbit) Payload(opt PayloadOption) (string, error) {
+	if o.AccessKey == "" {
+		return "", newValidationError("AccessKey", "AccessKey is required!")
+	}
+	if o.secretKey == "" {
+		return "", newValidationError("SecretKey", "Please set a secret key with SetSecretKey!")
+	}
 	claim := jwt.MapClaims{}
 	claim["access_key"] = o.AccessKey
 	claim["nonce"] = uuid.New()
@@ -93,46 +302,213 @@ func (o *Upbit) payload(opt PayloadOption) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	o.mu.Lock()
 	o.token = "Bearer " + token
-	return o.token, nil
+	o.mu.Unlock()
+	return "Bearer " + token, nil
 }
 
-// [Exchange API] 전체 계좌 조회 @ accounts
-//  내가 보유한 자산 리스트를 보여줍니다.
-func (o *Upbit) Accounts() UpbitAccounts {
-	o.payload(PayloadOption{WithParams: false})
-	req, _ := http.NewRequest("GET", UPBIT_URL_ACCOUNTS, nil)
-	if o.token == "" {
-		panic("Please do `Payload` first.")
+// 쿼리 문자열 만들기
+//  query_hash 와 실제 요청이 같은 문자열을 사용하도록 키 순서대로 인코딩합니다.
+//	배열 파라미터(ex. states[])의 대괄호는 인코딩하지 않습니다.
+func encodeQuery(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
 	}
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Authorization", o.token)
+	sort.Strings(keys)
+
+	var buf strings.Builder
+	for _, k := range keys {
+		key := strings.ReplaceAll(url.QueryEscape(k), "%5B%5D", "[]")
+		for _, v := range params[k] {
+			if buf.Len() > 0 {
+				buf.WriteByte('&')
+			}
+			buf.WriteString(key)
+			buf.WriteByte('=')
+			buf.WriteString(url.QueryEscape(v))
+		}
+	}
+	return buf.String()
+}
 
-	var res UpbitAccounts
+// query_hash 를 계산할 문자열
+//  업비트 문서와 같이 인코딩한 쿼리 문자열을 다시 디코딩합니다. (unquote(urlencode(params)))
+//	공백은 인코딩된 그대로 "+" 로 남습니다.
+func hashQuery(query string) string {
+	unescaped, err := url.PathUnescape(query)
+	if err != nil {
+		return query
+	}
+	return unescaped
+}
+
+// 요청 URL 만들기
+//  UPBIT_URL_* 의 호스트(UPBIT_HOST, UPBIT_WEBSOCKET_HOST)를 세팅한 API 호스트로 바꿉니다.
+//	웹소켓 URL 은 API 호스트의 스킴을 ws, wss 로 바꿔 사용합니다.
+// Params:
+//	targetUrl = 요청 URL (ex. UPBIT_URL_ACCOUNTS)
+func (o *Upbit) ResolveURL(targetUrl string) string {
+	if strings.HasPrefix(targetUrl, UPBIT_WEBSOCKET_HOST) {
+		base := o.baseUrl
+		if strings.HasPrefix(base, "https://") {
+			base = "wss://" + strings.TrimPrefix(base, "https://")
+		} else if strings.HasPrefix(base, "http://") {
+			base = "ws://" + strings.TrimPrefix(base, "http://")
+		}
+		return base + strings.TrimPrefix(targetUrl, UPBIT_WEBSOCKET_HOST)
+	}
+	return o.baseUrl + strings.TrimPrefix(targetUrl, UPBIT_HOST)
+}
+
+// HTTP 요청 보내기
+//  GET, DELETE 는 파라미터를 쿼리 문자열로, POST 는 JSON 본문으로 보냅니다.
+//	withAuth 가 true 이면 파라미터로 query_hash 를 만들어 인증 헤더를 추가합니다.
+// Params:
+//	ctx = 요청 context
+//	method = HTTP 메소드
+//	targetUrl = 요청 URL
+//	params = 요청 파라미터
+//	withAuth = 인증 필요 여부
+func (o *Upbit) request(ctx context.Context, method string, targetUrl string, params url.Values, withAuth bool) ([]byte, UpbitCommonBlock) {
+	var common UpbitCommonBlock
+	query := encodeQuery(params)
+	targetUrl = o.ResolveURL(targetUrl)
+
+	var encoded []byte
+	if method == http.MethodPost {
+		body := map[string]interface{}{}
+		for k, v := range params {
+			if strings.HasSuffix(k, "[]") {
+				body[strings.TrimSuffix(k, "[]")] = v
+			} else {
+				body[k] = v[0]
+			}
+		}
+		var err error
+		encoded, err = json.Marshal(body)
+		if err != nil {
+			common.Error = err
+			return nil, common
+		}
+	} else if query != "" {
+		targetUrl = targetUrl + "?" + query
+	}
+
+	canRetry := idempotent(method, params)
+	for attempt := 1; ; attempt++ {
+		body, common, transient, wait := o.send(ctx, method, targetUrl, query, encoded, withAuth)
+		common.Attempts = attempt
+		if info, ok := ctx.Value(responseInfoKey{}).(*ResponseInfo); ok {
+			info.Attempts = attempt
+		}
+		if !canRetry || attempt >= o.retryPolicy.MaxAttempts || !retryable(ctx, common, transient) {
+			return body, common
+		}
 
-	httpRes, httpErr := http.DefaultClient.Do(req)
+		if backoff := o.retryPolicy.Backoff(attempt); backoff > wait {
+			wait = backoff
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return body, common
+		case <-timer.C:
+		}
+	}
+}
+
+// HTTP 요청 한 번 보내기
+//  재시도마다 새 nonce 로 인증 헤더를 만듭니다.
+//	transient 는 응답을 받지 못한 네트워크 오류 여부, wait 는 응답 헤더가 요구하는 최소 재시도 대기 시간입니다.
+func (o *Upbit) send(ctx context.Context, method string, targetUrl string, query string, encoded []byte, withAuth bool) (body []byte, common UpbitCommonBlock, transient bool, wait time.Duration) {
+	var reqBody io.Reader
+	if encoded != nil {
+		reqBody = bytes.NewReader(encoded)
+	}
+	req, reqErr := http.NewRequestWithContext(ctx, method, targetUrl, reqBody)
+	if reqErr != nil {
+		common.Error = reqErr
+		return nil, common, false, 0
+	}
+	req.Header.Add("Accept", "application/json")
+	if o.userAgent != "" {
+		req.Header.Set("User-Agent", o.userAgent)
+	}
+	if reqBody != nil {
+		req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	}
+	if withAuth {
+		token, err := o.Payload(PayloadOption{WithParams: query != "", Params: hashQuery(query)})
+		if err != nil {
+			common.Error = err
+			return nil, common, false, 0
+		}
+		req.Header.Add("Authorization", token)
+	}
+
+	if o.rateLimiter != nil {
+		if err := o.rateLimiter.Wait(ctx, method, req.URL.Path); err != nil {
+			common.Error = err
+			return nil, common, false, 0
+		}
+	}
+	start := time.Now()
+	httpRes, httpErr := o.httpClient.Do(req)
 	if httpErr != nil {
-		res.Common.Error = httpErr
-		return res
+		common.Error = httpErr
+		return nil, common, true, 0
 	}
 	body, ioErr := ioutil.ReadAll(httpRes.Body)
 	defer httpRes.Body.Close()
+	common.StatusCode = httpRes.StatusCode
+	if o.rateLimiter != nil {
+		o.rateLimiter.Update(method, req.URL.Path, httpRes.Header)
+	}
+	if info, ok := ctx.Value(responseInfoKey{}).(*ResponseInfo); ok {
+		info.fill(httpRes, time.Since(start))
+	}
 	if ioErr != nil {
-		res.Common.Error = ioErr
-		return res
+		common.Error = ioErr
+		return nil, common, true, 0
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
+	if httpRes.StatusCode < 200 || httpRes.StatusCode > 299 {
+		common.Error = ParseAPIError(httpRes.StatusCode, body)
+		wait = retryAfter(httpRes.Header, time.Now())
+	}
+	return body, common, false, wait
+}
+
+// 응답 본문 디코딩
+//  실패하면 본문을 담은 DecodeError 를 반환합니다.
+func decode(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return &DecodeError{Body: body, Err: err}
+	}
+	return nil
+}
+
+// [Exchange API] 전체 계좌 조회 @ accounts
+//  내가 보유한 자산 리스트를 보여줍니다.
+func (o *Upbit) Accounts() UpbitAccounts {
+	return o.AccountsContext(context.Background())
+}
 
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+// Accounts 의 context 버전
+func (o *Upbit) AccountsContext(ctx context.Context) UpbitAccounts {
+	var res UpbitAccounts
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_ACCOUNTS, nil, true)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
 	var blocks []UpbitAccountBlock
-	json.Unmarshal([]byte(content), &blocks)
-	res.Common.StatusCode = httpRes.StatusCode
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
 	res.Response = blocks
 	return res
 }
@@ -143,42 +519,25 @@ func (o *Upbit) Accounts() UpbitAccounts {
 //	bidCurrencyTicker = 매수 시 사용할 통화
 //	AskCurrencyTicker = 매도 시 사용할 통화
 func (o *Upbit) OrdersChance(bidCurrencyTicker string, AskCurrencyTicker string) UpbitOrdersChance {
+	return o.OrdersChanceContext(context.Background(), bidCurrencyTicker, AskCurrencyTicker)
+}
+
+// OrdersChance 의 context 버전
+func (o *Upbit) OrdersChanceContext(ctx context.Context, bidCurrencyTicker string, AskCurrencyTicker string) UpbitOrdersChance {
 	params := url.Values{}
 	params.Add("market", fmt.Sprintf("%s-%s", bidCurrencyTicker, AskCurrencyTicker))
-	url := UPBIT_URL_ORDERS_CHANCE + "?" + params.Encode()
-	o.payload(PayloadOption{WithParams: true, Params: params.Encode()})
-	req, _ := http.NewRequest("GET", url, nil)
-	if o.token == "" {
-		panic("Please do `Payload` first.")
-	}
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Authorization", o.token)
 
 	var res UpbitOrdersChance
-
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_ORDERS_CHANCE, params, true)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
-		return res
-	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
-
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+	var block UpbitOrdersChanceBlock
+	if err := decode(body, &block); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	var block UpbitOrdersChanceBlock
-	json.Unmarshal([]byte(content), &block)
-	res.Common.StatusCode = httpRes.StatusCode
 	res.Response = block
 	return res
 }
@@ -198,9 +557,16 @@ type OrderOption struct {
 //	uuid = 주문 UUID
 //	identifier = 조회용 사용자 지정 값
 func (o *Upbit) Order(opt OrderOption) UpbitOrder {
+	return o.OrderContext(context.Background(), opt)
+}
+
+// Order 의 context 버전
+func (o *Upbit) OrderContext(ctx context.Context, opt OrderOption) UpbitOrder {
 	params := url.Values{}
 	if opt.Uuid == "" && opt.Identifier == "" {
-		panic("Please configure Uuid or Identifier!")
+		var res UpbitOrder
+		res.Common.Error = newValidationError("Uuid", "Please configure Uuid or Identifier!")
+		return res
 	}
 
 	if opt.Uuid != "" {
@@ -209,39 +575,371 @@ func (o *Upbit) Order(opt OrderOption) UpbitOrder {
 		params.Add("identifier", opt.Identifier)
 	}
 
-	encodedParams := params.Encode()
+	var res UpbitOrder
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_ORDER, params, true)
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var block UpbitOrderBlock
+	if err := decode(body, &block); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	res.Response = block
+	return res
+}
 
-	url := UPBIT_URL_ORDER + "?" + encodedParams
-	o.payload(PayloadOption{WithParams: true, Params: encodedParams})
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Authorization", o.token)
+// [Exchange API] 주문 취소 접수 @ order
+//  주문 UUID 를 통해 해당 주문에 대한 취소 접수를 한다.
+//	uuid 혹은 identifier 둘 중 하나의 값이 반드시 포함되어야 합니다.
+// Params:
+//	uuid = 취소할 주문의 UUID
+//	identifier = 조회용 사용자 지정 값
+func (o *Upbit) CancelOrder(opt OrderOption) UpbitOrder {
+	return o.CancelOrderContext(context.Background(), opt)
+}
 
+// CancelOrder 의 context 버전
+func (o *Upbit) CancelOrderContext(ctx context.Context, opt OrderOption) UpbitOrder {
 	var res UpbitOrder
+	params := url.Values{}
+	if opt.Uuid != "" {
+		params.Add("uuid", opt.Uuid)
+	} else if opt.Identifier != "" {
+		params.Add("identifier", opt.Identifier)
+	} else {
+		res.Common.Error = newValidationError("Uuid", "Please configure Uuid or Identifier!")
+		return res
+	}
 
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+	body, common := o.request(ctx, http.MethodDelete, UPBIT_URL_ORDER, params, true)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
+	var block UpbitOrderBlock
+	if err := decode(body, &block); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	res.Response = block
+	return res
+}
+
+const (
+	// 주문 일괄 취소 시 동시에 보낼 최대 요청 수
+	CANCEL_ORDERS_CONCURRENCY = 4
+)
+
+// 주문 리스트 조회 옵션
+type OrdersOption struct {
+	// 마켓 ID (ex. KRW-BTC)
+	Market string
+	// 주문 UUID 목록
+	Uuids []string
+	// 주문 identifier 목록
+	Identifiers []string
+	// 주문 상태 (States 와 함께 사용 불가)
+	State OrderState
+	// 주문 상태 목록 (State 와 함께 사용 불가)
+	States []OrderState
+	// 페이지 수 (기본값 : 1)
+	Page int
+	// 요청 개수 (기본값 : 100, 최대 100)
+	Limit int
+	// 정렬 방식 asc, desc (기본값 : desc)
+	OrderBy string
+}
+
+// [Exchange API] 주문 리스트 조회 @ orders
+//  주문 리스트를 조회한다.
+//	한 페이지씩 조회합니다. 전체를 조회하려면 OrdersIterator 를 사용하세요.
+// Params:
+//	opt = 주문 리스트 조회 옵션
+func (o *Upbit) Orders(opt OrdersOption) UpbitOrders {
+	return o.OrdersContext(context.Background(), opt)
+}
+
+// Orders 의 context 버전
+func (o *Upbit) OrdersContext(ctx context.Context, opt OrdersOption) UpbitOrders {
+	var res UpbitOrders
+	params := url.Values{}
+	if opt.Market != "" {
+		params.Add("market", opt.Market)
+	}
+	for _, v := range opt.Uuids {
+		params.Add("uuids[]", v)
+	}
+	for _, v := range opt.Identifiers {
+		params.Add("identifiers[]", v)
+	}
+	if opt.State != "" && len(opt.States) > 0 {
+		res.Common.Error = newValidationError("States", "State and States can not be used together!")
+		return res
+	}
+	if opt.State != "" {
+		params.Add("state", string(opt.State))
+	}
+	for _, v := range opt.States {
+		params.Add("states[]", string(v))
+	}
+	if opt.Page > 0 {
+		params.Add("page", strconv.Itoa(opt.Page))
+	}
+	if opt.Limit > 0 {
+		if opt.Limit > 100 {
+			res.Common.Error = newValidationError("Limit", "Limit field only accept until 100!")
+			return res
+		}
+		params.Add("limit", strconv.Itoa(opt.Limit))
+	}
+	if opt.OrderBy != "" {
+		if opt.OrderBy != "asc" && opt.OrderBy != "desc" {
+			res.Common.Error = newValidationError("OrderBy", "OrderBy must be `asc` or `desc`!")
+			return res
+		}
+		params.Add("order_by", opt.OrderBy)
+	}
+
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_ORDERS, params, true)
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var blocks []UpbitOrderBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	res.Response = blocks
+	return res
+}
+
+// 대기 주문 일괄 취소
+//  마켓의 대기 주문을 모두 조회한 뒤 동시에 취소 접수합니다.
+//	동시 요청 수는 CANCEL_ORDERS_CONCURRENCY 로, 초당 요청 수는 클라이언트의 RateLimiter 로 제한됩니다.
+//	주문별 성공/실패는 Response[].Result.Common 에서 확인할 수 있고,
+//	Common.Error 는 대기 주문 조회가 실패했을 때만 채워집니다.
+//	WithResponseInfo 의 ResponseInfo 는 모든 취소가 끝난 뒤 목록의 마지막 취소 요청 기준으로 한 번 채워집니다.
+// Params:
+//	market = 마켓 ID (ex. KRW-BTC)
+//	side = 주문 종류. 비워서 요청시 매수/매도 모두 취소
+func (o *Upbit) CancelOpenOrders(market string, side OrderSide) UpbitCancelOrders {
+	return o.CancelOpenOrdersContext(context.Background(), market, side)
+}
+
+// CancelOpenOrders 의 context 버전
+func (o *Upbit) CancelOpenOrdersContext(ctx context.Context, market string, side OrderSide) UpbitCancelOrders {
+	var res UpbitCancelOrders
+	if market == "" {
+		res.Common.Error = newValidationError("Market", "Market is required!")
+		return res
+	}
+
+	var orders []UpbitOrderBlock
+	it := o.OrdersIteratorContext(ctx, OrdersOption{Market: market, State: ORDER_STATE_WAIT}, time.Time{}, time.Time{})
+	for it.Next() {
+		if side == "" || it.Order().Side == string(side) {
+			orders = append(orders, it.Order())
+		}
+	}
+	res.Common = it.Common()
+	if res.Common.Error != nil {
+		return res
+	}
+
+	res.Response = make([]UpbitCancelOrderBlock, len(orders))
+	// 동시에 보내는 요청이 같은 ResponseInfo 를 쓰지 않도록 주문별로 받고 끝난 뒤 한 번 채움
+	info, _ := ctx.Value(responseInfoKey{}).(*ResponseInfo)
+	infos := make([]ResponseInfo, len(orders))
+	sem := make(chan struct{}, CANCEL_ORDERS_CONCURRENCY)
+	var wg sync.WaitGroup
+	for i, order := range orders {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			res.Response[i] = UpbitCancelOrderBlock{Uuid: order.Uuid}
+			res.Response[i].Result.Common.Error = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, uuid string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res.Response[i] = UpbitCancelOrderBlock{
+				Uuid:   uuid,
+				Result: o.CancelOrderContext(context.WithValue(ctx, responseInfoKey{}, &infos[i]), OrderOption{Uuid: uuid}),
+			}
+		}(i, order.Uuid)
+	}
+	wg.Wait()
+	if info != nil {
+		for i := len(infos) - 1; i >= 0; i-- {
+			if infos[i].Attempts > 0 {
+				*info = infos[i]
+				break
+			}
+		}
+	}
+	return res
+}
+
+// 주문하기 옵션
+//  주문 방식(OrdType)에 따라 필요한 값이 다릅니다.
+//	limit = Volume, Price 필수
+//	price = 시장가 매수. Price(매수 총액) 필수, Volume 불가
+//	market = 시장가 매도. Volume 필수, Price 불가
+//	best = 최유리 주문. TimeInForce(ioc, fok) 필수, 매수는 Price, 매도는 Volume 필수
+type PlaceOrderOption struct {
+	// 마켓 ID (ex. KRW-BTC)
+	Market string
+	// 주문 종류
+	Side OrderSide
+	// 주문 방식
+	OrdType OrderType
+	// 주문량 [NumberString]
+	Volume string
+	// 주문 가격 [NumberString]
+	Price string
+	// 조회용 사용자 지정 값
+	Identifier string
+	// 주문 체결 조건
+	TimeInForce TimeInForce
+}
+
+// 주문하기 옵션 검사
+//  요청을 보내기 전에 잘못된 조합을 걸러냅니다.
+func (opt PlaceOrderOption) Validate() error {
+	if errs := opt.validationErrors(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// 주문하기 옵션의 잘못된 필드 모두 찾기
+//  Validate 는 이 중 첫 번째를 반환하고, OrderValidator 는 모두 위반 사항으로 돌려줍니다.
+func (opt PlaceOrderOption) validationErrors() []*ValidationError {
+	var errs []*ValidationError
+	add := func(field string, message string) {
+		errs = append(errs, newValidationError(field, message))
+	}
+	if opt.Market == "" {
+		add("Market", "Market is required!")
+	}
+	if opt.Side != ORDER_SIDE_BID && opt.Side != ORDER_SIDE_ASK {
+		add("Side", "Side must be `bid` or `ask`!")
+	}
+
+	switch opt.OrdType {
+	case ORDER_TYPE_LIMIT:
+		if opt.Volume == "" {
+			add("Volume", "Limit order needs both Volume and Price!")
+		}
+		if opt.Price == "" {
+			add("Price", "Limit order needs both Volume and Price!")
+		}
+	case ORDER_TYPE_PRICE:
+		if opt.Side == ORDER_SIDE_ASK {
+			add("Side", "Market price order(`price`) is only for bid!")
+		}
+		errs = append(errs, opt.only("Price", "Market price order(`price`) needs Price only!")...)
+	case ORDER_TYPE_MARKET:
+		if opt.Side == ORDER_SIDE_BID {
+			add("Side", "Market order(`market`) is only for ask!")
+		}
+		errs = append(errs, opt.only("Volume", "Market order(`market`) needs Volume only!")...)
+	case ORDER_TYPE_BEST:
+		if opt.TimeInForce != TIME_IN_FORCE_IOC && opt.TimeInForce != TIME_IN_FORCE_FOK {
+			add("TimeInForce", "Best order needs TimeInForce `ioc` or `fok`!")
+		}
+		if opt.Side == ORDER_SIDE_BID {
+			errs = append(errs, opt.only("Price", "Best bid order needs Price only!")...)
+		}
+		if opt.Side == ORDER_SIDE_ASK {
+			errs = append(errs, opt.only("Volume", "Best ask order needs Volume only!")...)
+		}
+	default:
+		add("OrdType", "OrdType was wrong!")
+	}
+
+	if opt.TimeInForce != TIME_IN_FORCE_NONE && opt.OrdType != ORDER_TYPE_BEST {
+		if opt.OrdType != ORDER_TYPE_LIMIT {
+			add("TimeInForce", "TimeInForce is only for `limit` or `best` order!")
+		} else if opt.TimeInForce != TIME_IN_FORCE_IOC && opt.TimeInForce != TIME_IN_FORCE_FOK {
+			add("TimeInForce", "TimeInForce was wrong!")
+		}
+	}
+	return errs
+}
+
+// Price, Volume 중 하나만 필요한 주문 검사
+//  field 가 비어 있으면 field 를, 다른 쪽이 채워져 있으면 다른 쪽을 잘못된 필드로 반환합니다.
+func (opt PlaceOrderOption) only(field string, message string) []*ValidationError {
+	required, other, otherField := opt.Price, opt.Volume, "Volume"
+	if field == "Volume" {
+		required, other, otherField = opt.Volume, opt.Price, "Price"
+	}
+	var errs []*ValidationError
+	if required == "" {
+		errs = append(errs, newValidationError(field, message))
+	}
+	if other != "" {
+		errs = append(errs, newValidationError(otherField, message))
+	}
+	return errs
+}
+
+// [Exchange API] 주문하기 @ orders
+//  주문 요청을 한다.
+//	잘못된 주문 옵션은 요청을 보내기 전에 Common.Error 로 반환됩니다.
+// Params:
+//	opt = 주문하기 옵션
+func (o *Upbit) PlaceOrder(opt PlaceOrderOption) UpbitOrder {
+	return o.PlaceOrderContext(context.Background(), opt)
+}
+
+// PlaceOrder 의 context 버전
+func (o *Upbit) PlaceOrderContext(ctx context.Context, opt PlaceOrderOption) UpbitOrder {
+	var res UpbitOrder
+	if err := opt.Validate(); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
 
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+	params := url.Values{}
+	params.Add("market", opt.Market)
+	params.Add("side", string(opt.Side))
+	params.Add("ord_type", string(opt.OrdType))
+	if opt.Volume != "" {
+		params.Add("volume", opt.Volume)
+	}
+	if opt.Price != "" {
+		params.Add("price", opt.Price)
+	}
+	if opt.Identifier != "" {
+		params.Add("identifier", opt.Identifier)
+	}
+	if opt.TimeInForce != TIME_IN_FORCE_NONE {
+		params.Add("time_in_force", string(opt.TimeInForce))
+	}
+
+	body, common := o.request(ctx, http.MethodPost, UPBIT_URL_ORDERS, params, true)
+	res.Common = common
+	if common.Attempts > 1 && errors.Is(common.Error, ErrDuplicatedIdentifier) {
+		// 응답을 받지 못한 앞선 시도가 접수된 경우이므로 identifier 로 접수된 주문을 조회
+		found := o.OrderContext(ctx, OrderOption{Identifier: opt.Identifier})
+		found.Common.Attempts += common.Attempts
+		return found
+	}
+	if common.Error != nil {
 		return res
 	}
 	var block UpbitOrderBlock
-	json.Unmarshal([]byte(content), &block)
-	res.Common.StatusCode = httpRes.StatusCode
+	if err := decode(body, &block); err != nil {
+		res.Common.Error = err
+		return res
+	}
 	res.Response = block
 	return res
 }
@@ -251,50 +949,135 @@ func (o *Upbit) Order(opt OrderOption) UpbitOrder {
 // Params:
 // 	isDetails = 유의종목 필드과 같은 상세 정보 노출 여부
 func (o *Upbit) MarketAll(isDetails bool) UpbitMarketAll {
+	return o.MarketAllContext(context.Background(), isDetails)
+}
+
+// MarketAll 의 context 버전
+func (o *Upbit) MarketAllContext(ctx context.Context, isDetails bool) UpbitMarketAll {
 	params := url.Values{}
 	params.Add("isDetails", strconv.FormatBool(isDetails))
-	var encodedUrl string
-	if len(params) > 0 {
-		encodedUrl = UPBIT_URL_MARKET_ALL + "?" + params.Encode()
-	} else {
-		encodedUrl = UPBIT_URL_MARKET_ALL
+
+	var res UpbitMarketAll
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_MARKET_ALL, params, false)
+	res.Common = common
+	if common.Error != nil {
+		return res
 	}
 
-	req, _ := http.NewRequest("GET", encodedUrl, nil)
-	req.Header.Add("Accept", "application/json")
+	var blocks []UpbitMarketAllBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
 
-	var res UpbitMarketAll
+	if len(blocks) <= 0 {
+		res.Common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+		return res
+	}
+
+	res.Response = blocks
+	return res
+}
+
+// 캔들 조회 공통 처리
+//  count 는 최대 200개까지 요청 가능합니다.
+// Params:
+//	ctx = 요청 context
+//	targetUrl = 캔들 URL
+// 	market = 마켓 코드 (ex. KRW-BTC)
+//	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
+//	count = 캔들 개수
+//	convertingPriceUnit = 종가 환산 화폐 단위 (생략 가능)
+func (o *Upbit) candles(ctx context.Context, targetUrl string, market string, to time.Time, count int, convertingPriceUnit string) ([]byte, UpbitCommonBlock) {
+	var common UpbitCommonBlock
+	params := url.Values{}
+	if market != "" {
+		params.Add("market", market)
+	}
+	if !to.IsZero() {
+		params.Add("to", to.UTC().Format(CANDLE_DATE_TIME_FORMAT+"Z"))
+	}
+	if count > 0 {
+		if count > 200 {
+			common.Error = newValidationError("Count", "Count field only accept until 200!")
+			return nil, common
+		}
+		params.Add("count", strconv.Itoa(count))
+	}
+	if convertingPriceUnit != "" {
+		params.Add("convertingPriceUnit", convertingPriceUnit)
+	}
+
+	body, common := o.request(ctx, http.MethodGet, targetUrl, params, false)
+	if common.Error != nil {
+		return body, common
+	}
+	var blocks []json.RawMessage
+	if err := decode(body, &blocks); err != nil {
+		common.Error = err
+		return body, common
+	}
+	if len(blocks) <= 0 {
+		common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+	}
+	return body, common
+}
+
+// [Quotation API] 캔들 조회 @ candles/{interval}
+//  캔들 단위만 바꿔서 초, 분, 일, 주, 월 캔들을 같은 형태(Candle)로 조회합니다.
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+//	interval = 캔들 단위 (ex. INTERVAL_MINUTE_1, INTERVAL_DAY)
+//	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
+//	count = 캔들 개수(최대 200개까지 요청 가능)
+func (o *Upbit) Candles(market string, interval Interval, to time.Time, count int) UpbitCandles {
+	return o.CandlesContext(context.Background(), market, interval, to, count)
+}
 
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+// Candles 의 context 버전
+func (o *Upbit) CandlesContext(ctx context.Context, market string, interval Interval, to time.Time, count int) UpbitCandles {
+	var res UpbitCandles
+	if !interval.valid() {
+		res.Common.Error = newValidationError("Interval", "Interval was wrong!")
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
+	body, common := o.candles(ctx, UPBIT_URL_CANDLES+string(interval), market, to, count, "")
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
-
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+	var blocks []Candle
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	res.Common.StatusCode = httpRes.StatusCode
+	res.Response = blocks
+	return res
+}
 
-	var blocks []UpbitMarketAllBlock
-	json.Unmarshal([]byte(content), &blocks)
+// [Quotation API] 초(Second) 캔들 @ candles/seconds
+//  최근 3개월 이내의 데이터만 조회 가능합니다.
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+// 	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
+//	count = 캔들 개수(최대 200개까지 요청 가능)
+func (o *Upbit) CandlesSeconds(market string, to time.Time, count int) UpbitCandlesSeconds {
+	return o.CandlesSecondsContext(context.Background(), market, to, count)
+}
 
-	if len(blocks) <= 0 {
-		res.Common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+// CandlesSeconds 의 context 버전
+func (o *Upbit) CandlesSecondsContext(ctx context.Context, market string, to time.Time, count int) UpbitCandlesSeconds {
+	var res UpbitCandlesSeconds
+	body, common := o.candles(ctx, UPBIT_URL_CANDLES_SECONDS, market, to, count, "")
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var blocks []UpbitCandlesSecondsBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-
 	res.Response = blocks
 	return res
 }
@@ -303,80 +1086,34 @@ func (o *Upbit) MarketAll(isDetails bool) UpbitMarketAll {
 // Params:
 // 	unit = 분 단위. 가능한 값 : 1, 3, 5, 15, 10, 30, 60, 240
 //	market = 마켓 코드 (ex. KRW-BTC)
-//	to = 마지막 캔들 시각 (exclusive). 포맷 : yyyy-MM-dd'T'HH:mm:ss'Z' or yyyy-MM-dd HH:mm:ss. 비워서 요청시 가장 최근 캔들
+//	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
 //	count = 캔들 개수(최대 200개까지 요청 가능)
-func (o *Upbit) CandlesMinutes(unit int, market string, to string, count int) UpbitCandlesMinutes {
-	var targetUrl string
-	params := url.Values{}
-	switch unit {
-	case 1:
-		fallthrough
-	case 3:
-		fallthrough
-	case 5:
-		fallthrough
-	case 15:
-		fallthrough
-	case 10:
-		fallthrough
-	case 30:
-		fallthrough
-	case 60:
-		fallthrough
-	case 240:
-		targetUrl = fmt.Sprintf(UPBIT_URL_CANDLES_MINUTES, unit)
-	default:
-		panic("unit was wrong!")
-	}
-	if market != "" {
-		params.Add("market", market)
-	}
-	if to != "" {
-		params.Add("to", to)
-	}
-	if count > 0 {
-		if count > 200 {
-			panic("Count field only accept until 200!")
-		}
-		params.Add("count", strconv.Itoa(count))
-	}
-
-	encodedUrl := targetUrl + "?" + params.Encode()
-	req, _ := http.NewRequest("GET", encodedUrl, nil)
-	req.Header.Add("Accept", "application/json")
+func (o *Upbit) CandlesMinutes(unit int, market string, to time.Time, count int) UpbitCandlesMinutes {
+	return o.CandlesMinutesContext(context.Background(), unit, market, to, count)
+}
 
+// CandlesMinutes 의 context 버전
+func (o *Upbit) CandlesMinutesContext(ctx context.Context, unit int, market string, to time.Time, count int) UpbitCandlesMinutes {
 	var res UpbitCandlesMinutes
-
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+	if !Interval(fmt.Sprintf("minutes/%d", unit)).valid() {
+		res.Common.Error = newValidationError("Unit", "unit was wrong!")
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
+	if count > 200 {
+		res.Common.Error = newValidationError("Count", "Count field only accept until 200!")
 		return res
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
 
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+	body, common := o.candles(ctx, fmt.Sprintf(UPBIT_URL_CANDLES_MINUTES, unit), market, to, count, "")
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	res.Common.StatusCode = httpRes.StatusCode
-
 	var blocks []UpbitCandlesMinutesBlock
-	json.Unmarshal([]byte(content), &blocks)
-
-	if len(blocks) <= 0 {
-		res.Common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-
 	res.Response = blocks
 	return res
 }
@@ -387,129 +1124,198 @@ func (o *Upbit) CandlesMinutes(unit int, market string, to string, count int) Up
 //	현재는 원화(KRW) 로 변환하는 기능만 제공하며 추후 기능을 확장할 수 있습니다.
 // Params:
 // 	market = 마켓 코드 (ex. KRW-BTC)
-// 	to = 마지막 캔들 시각 (exclusive). 포맷 : yyyy-MM-dd'T'HH:mm:ss'Z' or yyyy-MM-dd HH:mm:ss. 비워서 요청시 가장 최근 캔들
+// 	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
 //	count = 캔들 개수
 //	convertingPriceUnit = 종가 환산 화폐 단위 (생략 가능, KRW로 명시할 시 원화 환산 가격을 반환.)
-func (o *Upbit) CandlesDays(market string, to string, count int, convertingPriceUnit string) UpbitCandlesDays {
-	params := url.Values{}
-	if market != "" {
-		params.Add("market", market)
-	}
-	if to != "" {
-		params.Add("to", to)
-	}
-	if count > 0 {
-		params.Add("count", strconv.Itoa(count))
-	}
-	if convertingPriceUnit != "" {
-		params.Add("convertingPriceUnit", convertingPriceUnit)
-	}
-
-	var encodedUrl string
-	if len(params) > 0 {
-		encodedUrl = UPBIT_URL_CANDLES_DAYS + "?" + params.Encode()
-	} else {
-		encodedUrl = UPBIT_URL_CANDLES_DAYS
-	}
-
-	req, _ := http.NewRequest("GET", encodedUrl, nil)
-	req.Header.Add("Accept", "application/json")
+func (o *Upbit) CandlesDays(market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesDays {
+	return o.CandlesDaysContext(context.Background(), market, to, count, convertingPriceUnit)
+}
 
+// CandlesDays 의 context 버전
+func (o *Upbit) CandlesDaysContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesDays {
 	var res UpbitCandlesDays
-
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+	body, common := o.candles(ctx, UPBIT_URL_CANDLES_DAYS, market, to, count, convertingPriceUnit)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
+	var blocks []UpbitCandlesDaysBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
+	res.Response = blocks[0]
+	return res
+}
+
+// [Quotation API] 주(Week) 캔들 @ candles/weeks
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+// 	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
+//	count = 캔들 개수
+//	convertingPriceUnit = 종가 환산 화폐 단위 (생략 가능)
+func (o *Upbit) CandlesWeeks(market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesWeeks {
+	return o.CandlesWeeksContext(context.Background(), market, to, count, convertingPriceUnit)
+}
 
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+// CandlesWeeks 의 context 버전
+func (o *Upbit) CandlesWeeksContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesWeeks {
+	var res UpbitCandlesWeeks
+	body, common := o.candles(ctx, UPBIT_URL_CANDLES_WEEKS, market, to, count, convertingPriceUnit)
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var blocks []UpbitCandlesWeeksBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	res.Common.StatusCode = httpRes.StatusCode
+	res.Response = blocks
+	return res
+}
 
-	var blocks []UpbitCandlesDaysBlock
-	json.Unmarshal([]byte(content), &blocks)
+// [Quotation API] 월(Month) 캔들 @ candles/months
+// Params:
+// 	market = 마켓 코드 (ex. KRW-BTC)
+// 	to = 마지막 캔들 시각 (exclusive). 영값(time.Time{})이면 가장 최근 캔들
+//	count = 캔들 개수
+//	convertingPriceUnit = 종가 환산 화폐 단위 (생략 가능)
+func (o *Upbit) CandlesMonths(market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesMonths {
+	return o.CandlesMonthsContext(context.Background(), market, to, count, convertingPriceUnit)
+}
 
-	if len(blocks) <= 0 {
-		res.Common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+// CandlesMonths 의 context 버전
+func (o *Upbit) CandlesMonthsContext(ctx context.Context, market string, to time.Time, count int, convertingPriceUnit string) UpbitCandlesMonths {
+	var res UpbitCandlesMonths
+	body, common := o.candles(ctx, UPBIT_URL_CANDLES_MONTHS, market, to, count, convertingPriceUnit)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-
-	res.Response = blocks[0]
+	var blocks []UpbitCandlesMonthsBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	res.Response = blocks
 	return res
 }
 
-// [Quotation API] 주(Week) 캔들 @ candles/weeks
+// [Quotation API] 최근 체결 내역 @ trades/ticks
 // Params:
 // 	market = 마켓 코드 (ex. KRW-BTC)
-// 	to = 마지막 캔들 시각 (exclusive). 포맷 : yyyy-MM-dd'T'HH:mm:ss'Z' or yyyy-MM-dd HH:mm:ss. 비워서 요청시 가장 최근 캔들
-//	count = 캔들 개수
-func (o *Upbit) CandlesWeeks(market string, to string, count int, convertingPriceUnit string) UpbitCandlesWeeks {
+//	to = 마지막 체결 시각. 형식 : [HHmmss 또는 HH:mm:ss]. 비워서 요청시 가장 최근 데이터
+//	count = 체결 개수 (최대 500개까지 요청 가능)
+//	cursor = 페이지네이션 커서 (sequentialId)
+//	daysAgo = 최근 영업일 기준 며칠 전 데이터 (1 ~ 7). 0 이면 당일
+func (o *Upbit) TradesTicks(market string, to string, count int, cursor string, daysAgo int) UpbitTradesTicks {
+	return o.TradesTicksContext(context.Background(), market, to, count, cursor, daysAgo)
+}
+
+// TradesTicks 의 context 버전
+func (o *Upbit) TradesTicksContext(ctx context.Context, market string, to string, count int, cursor string, daysAgo int) UpbitTradesTicks {
+	var res UpbitTradesTicks
 	params := url.Values{}
-	if market != "" {
-		params.Add("market", market)
+	if market == "" {
+		res.Common.Error = newValidationError("Market", "Market is required!")
+		return res
 	}
+	params.Add("market", market)
 	if to != "" {
 		params.Add("to", to)
 	}
 	if count > 0 {
+		if count > 500 {
+			res.Common.Error = newValidationError("Count", "Count field only accept until 500!")
+			return res
+		}
 		params.Add("count", strconv.Itoa(count))
 	}
-
-	var encodedUrl string
-	if len(params) > 0 {
-		encodedUrl = UPBIT_URL_CANDLES_WEEKS + "?" + params.Encode()
-	} else {
-		encodedUrl = UPBIT_URL_CANDLES_WEEKS
+	if cursor != "" {
+		params.Add("cursor", cursor)
+	}
+	if daysAgo > 0 {
+		if daysAgo > 7 {
+			res.Common.Error = newValidationError("DaysAgo", "DaysAgo field only accept until 7!")
+			return res
+		}
+		params.Add("daysAgo", strconv.Itoa(daysAgo))
 	}
 
-	req, _ := http.NewRequest("GET", encodedUrl, nil)
-	req.Header.Add("Accept", "application/json")
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_TRADES_TICKS, params, false)
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var blocks []UpbitTradesTicksBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
+	res.Response = blocks
+	return res
+}
 
-	var res UpbitCandlesWeeks
+// [Quotation API] 현재가 정보 @ ticker
+//  요청 당시 종목의 스냅샷을 반환한다.
+// Params:
+//	markets = 마켓 코드 목록 (ex. KRW-BTC, BTC-ETH)
+func (o *Upbit) Ticker(markets []string) UpbitTicker {
+	return o.TickerContext(context.Background(), markets)
+}
 
-	httpRes, httpErr := http.DefaultClient.Do(req)
-	if httpErr != nil {
-		res.Common.Error = httpErr
+// Ticker 의 context 버전
+func (o *Upbit) TickerContext(ctx context.Context, markets []string) UpbitTicker {
+	var res UpbitTicker
+	if len(markets) <= 0 {
+		res.Common.Error = newValidationError("Markets", "Markets is required!")
 		return res
 	}
-	body, ioErr := ioutil.ReadAll(httpRes.Body)
-	defer httpRes.Body.Close()
-	if ioErr != nil {
-		res.Common.Error = ioErr
+	params := url.Values{}
+	params.Add("markets", strings.Join(markets, ","))
+
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_TICKER, params, false)
+	res.Common = common
+	if common.Error != nil {
 		return res
 	}
-	content := string(body[:])
-	if httpRes.StatusCode != 200 {
-		var errorBlock UpbitErrorResponse
-		json.Unmarshal([]byte(content), &errorBlock)
-
-		res.Common.StatusCode = httpRes.StatusCode
-		res.Common.Error = errors.New(errorBlock.ErrorBlock.Name + " (" + errorBlock.ErrorBlock.Message + ")")
+	var blocks []UpbitTickerBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
 		return res
 	}
-	res.Common.StatusCode = httpRes.StatusCode
+	res.Response = blocks
+	return res
+}
 
-	var blocks []UpbitCandlesWeeksBlock
-	json.Unmarshal([]byte(content), &blocks)
+// [Quotation API] 호가 정보 조회 @ orderbook
+// Params:
+//	markets = 마켓 코드 목록 (ex. KRW-BTC, BTC-ETH)
+func (o *Upbit) Orderbook(markets []string) UpbitOrderbook {
+	return o.OrderbookContext(context.Background(), markets)
+}
 
-	if len(blocks) <= 0 {
-		res.Common.Error = errors.New("HTTP STATUS IS 200 BUT RESULT IS EMPTY")
+// Orderbook 의 context 버전
+func (o *Upbit) OrderbookContext(ctx context.Context, markets []string) UpbitOrderbook {
+	var res UpbitOrderbook
+	if len(markets) <= 0 {
+		res.Common.Error = newValidationError("Markets", "Markets is required!")
 		return res
 	}
+	params := url.Values{}
+	params.Add("markets", strings.Join(markets, ","))
 
+	body, common := o.request(ctx, http.MethodGet, UPBIT_URL_ORDERBOOK, params, false)
+	res.Common = common
+	if common.Error != nil {
+		return res
+	}
+	var blocks []UpbitOrderbookBlock
+	if err := decode(body, &blocks); err != nil {
+		res.Common.Error = err
+		return res
+	}
 	res.Response = blocks
 	return res
 }
@@ -531,6 +1337,8 @@ type UpbitCommonBlock struct {
 	StatusCode int
 	// Error
 	Error error
+	// 요청 횟수 (재시도 포함, 요청을 보내지 않았으면 0)
+	Attempts int
 }
 
 // 전체 계좌 조회 @ accounts 결과
@@ -551,12 +1359,36 @@ type UpbitOrder struct {
 	Common   UpbitCommonBlock
 }
 
+// 주문 리스트 조회 @ orders 결과
+type UpbitOrders struct {
+	Response []UpbitOrderBlock
+	Common   UpbitCommonBlock
+}
+
+// 주문 일괄 취소 결과
+type UpbitCancelOrders struct {
+	Response []UpbitCancelOrderBlock
+	Common   UpbitCommonBlock
+}
+
 // 마켓 코드 조회 @ market/all
 type UpbitMarketAll struct {
 	Response []UpbitMarketAllBlock
 	Common   UpbitCommonBlock
 }
 
+// 캔들 조회 @ candles 결과
+type UpbitCandles struct {
+	Response []Candle
+	Common   UpbitCommonBlock
+}
+
+// 초(Second) 캔들 @ candles/seconds 결과
+type UpbitCandlesSeconds struct {
+	Response []UpbitCandlesSecondsBlock
+	Common   UpbitCommonBlock
+}
+
 // 분(Minute) 캔들 @ candles/minutes 결과
 type UpbitCandlesMinutes struct {
 	Response []UpbitCandlesMinutesBlock
@@ -575,16 +1407,40 @@ type UpbitCandlesWeeks struct {
 	Common   UpbitCommonBlock
 }
 
+// 최근 체결 내역 @ trades/ticks 결과
+type UpbitTradesTicks struct {
+	Response []UpbitTradesTicksBlock
+	Common   UpbitCommonBlock
+}
+
+// 현재가 정보 @ ticker 결과
+type UpbitTicker struct {
+	Response []UpbitTickerBlock
+	Common   UpbitCommonBlock
+}
+
+// 호가 정보 조회 @ orderbook 결과
+type UpbitOrderbook struct {
+	Response []UpbitOrderbookBlock
+	Common   UpbitCommonBlock
+}
+
+// 월(Month) 캔들 @ candles/months 결과
+type UpbitCandlesMonths struct {
+	Response []UpbitCandlesMonthsBlock
+	Common   UpbitCommonBlock
+}
+
 // 전체 계좌 조회 @ accounts Block
 type UpbitAccountBlock struct {
 	// 화폐를 의미하는 영문 대문자 코드 [Stirng]
 	Currency string `json:"currency"`
 	// 주문가능 금액/수량 [NumberString]
-	Balance int64 `json:"balance"`
+	Balance Decimal `json:"balance"`
 	// 주문 중 묶여있는 금액/수량 [NumberString]
-	Locked int64 `json:"locked"`
+	Locked Decimal `json:"locked"`
 	// 매수평균가 [NumberString]
-	AvgBuyPrice int64 `json:"avg_buy_price"`
+	AvgBuyPrice Decimal `json:"avg_buy_price"`
 	// 매수평균가 수정 여부	[Boolean]
 	AvgBuyPriceModified bool `json:"avg_buy_price_modified"`
 	// 평단가 기준 화폐	[String]
@@ -593,8 +1449,10 @@ type UpbitAccountBlock struct {
 
 // 주문 가능 정보 @ orders/chance Block
 type UpbitOrdersChanceBlock struct {
+	// 매수 수수료 비율 [NumberString]
+	BidFee Decimal `json:"bid_fee"`
 	// 매도 수수료 비율 [NumberString]
-	AskFee string `json:"ask_fee"`
+	AskFee Decimal `json:"ask_fee"`
 	// 마켓에 대한 정보 [Object]
 	Market MarketBlock `json:"market"`
 	// 매수 시 사용하는 화폐의 계좌 상태 [Object]
@@ -617,7 +1475,7 @@ type MarketBlock struct {
 	// 매도 시 제약 사항 [Object]
 	Ask BidAskBlock `json:"ask"`
 	// 최대 매도/매수 금액 [NumberString]
-	MaxTotal string `json:"max_total"`
+	MaxTotal Decimal `json:"max_total"`
 	// 마켓 운영 상태 [String]
 	State string `json:"state"`
 }
@@ -628,8 +1486,8 @@ type BidAskBlock struct {
 	Currency string `json:"currency"`
 	// 주문금액 단위 [String]
 	PriceUnit string `json:"price_unit"`
-	// 최소 매도/매수 금액 [Number]
-	MinTotal int32 `json:"min_total"`
+	// 최소 매도/매수 금액 [NumberString]
+	MinTotal Decimal `json:"min_total"`
 }
 
 // 매수/매도 시 사용하는 화폐의 계좌 상태
@@ -637,11 +1495,11 @@ type BidAskAccountBlock struct {
 	// 화폐를 의미하는 영문 대문자 코드	[String]
 	Currency string `json:"currency"`
 	// 주문가능 금액/수량 [NumberString]
-	Balance string `json:"balance"`
+	Balance Decimal `json:"balance"`
 	// 주문 중 묶여있는 금액/수량 [NumberString]
-	Locked string `json:"locked"`
+	Locked Decimal `json:"locked"`
 	// 매수평균가 [NumberString]
-	AvgBuyPrice string `json:"avg_buy_price"`
+	AvgBuyPrice Decimal `json:"avg_buy_price"`
 	// 매수평균가 수정 여부 [Boolean]
 	AvgBuyPriceModified bool `json:"avg_buy_price_modified"`
 	// 평단가 기준 화폐 [String]
@@ -657,33 +1515,41 @@ type UpbitOrderBlock struct {
 	// 주문 방식 [String]
 	OrdType string `json:"ord_type"`
 	// 주문 당시 화폐 가격 [NumberString]
-	Price string `json:"price"`
+	Price Decimal `json:"price"`
 	// 주문 상태 [String]
 	State string `json:"state"`
 	// 마켓의 유일키 [String]
 	Market string `json:"market"`
 	// 주문 생성 시간 [DateString]
-	CreatedAt string `json:"created_at"`
+	CreatedAt time.Time `json:"created_at"`
 	// 사용자가 입력한 주문 양 [NumberString]
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 	// 체결 후 남은 주문 양 [NumberString]
-	RemainingVolume string `json:"remaining_volume"`
+	RemainingVolume Decimal `json:"remaining_volume"`
 	// 수수료로 예약된 비용 [NumberString]
-	ReservedFee string `json:"reserved_fee"`
+	ReservedFee Decimal `json:"reserved_fee"`
 	// 남은 수수료 [NumberString]
-	RemainingFee string `json:"remaining_fee"`
+	RemainingFee Decimal `json:"remaining_fee"`
 	// 사용된 수수료 [NumberString]
-	PaidFee string `json:"paid_fee"`
+	PaidFee Decimal `json:"paid_fee"`
 	// 거래에 사용중인 비용 [NumberString]
-	Locked string `json:"locked"`
+	Locked Decimal `json:"locked"`
 	// 체결된 양 [NumberString]
-	ExecutedVolume string `json:"executed_volume"`
+	ExecutedVolume Decimal `json:"executed_volume"`
 	// 해당 주문에 걸린 체결 수 [Integer]
 	TradeCount int `json:"trade_count"`
 	// 체결 [Array[Object]]
 	Trades []TradeBlock `json:"trades"`
 }
 
+// 주문 일괄 취소 Block
+type UpbitCancelOrderBlock struct {
+	// 취소 요청한 주문의 고유 아이디
+	Uuid string
+	// 주문 취소 접수 결과 (실패 시 Result.Common.Error)
+	Result UpbitOrder
+}
+
 // 체결 Block [Object]
 type TradeBlock struct {
 	// 마켓의 유일 키 [String]
@@ -691,15 +1557,15 @@ type TradeBlock struct {
 	// 체결의 고유 아이디 [String]
 	Uuid string `json:"uuid"`
 	// 체결 가격 [NumberString]
-	Price string `json:"price"`
+	Price Decimal `json:"price"`
 	// 체결 양 [NumberString]
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 	// 체결된 총 가격 [NumberString]
-	Funds string `json:"funds"`
+	Funds Decimal `json:"funds"`
 	// 체결 종류 [String]
 	Side string `json:"side"`
 	// 체결 시각 [DateString]
-	CreatedAt string `json:"created_at"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // 마켓 코드 조회 @ market/all Block
@@ -714,14 +1580,75 @@ type UpbitMarketAllBlock struct {
 	MarketWarning string `json:"market_warning"`
 }
 
+// 캔들 공통 Block
+//  캔들 단위에 따라 채워지는 필드가 다릅니다.
+type Candle struct {
+	// 마켓명 [String]
+	Market string `json:"market"`
+	// 캔들 기준 시각(UTC 기준) [String]
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
+	// 캔들 기준 시각(KST 기준)	[String]
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
+	// 시가	[Double]
+	OpeningPrice float64 `json:"opening_price"`
+	// 고가	[Double]
+	HighPrice float64 `json:"high_price"`
+	// 저가	[Double]
+	LowPrice float64 `json:"low_price"`
+	// 종가	[Double]
+	TradePrice float64 `json:"trade_price"`
+	// 해당 캔들에서 마지막 틱이 저장된 시각 [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 누적 거래 금액 [Double]
+	CandleAccTradePrice float64 `json:"candle_acc_trade_price"`
+	// 누적 거래량	[Double]
+	CandleAccTradeVolume float64 `json:"candle_acc_trade_volume"`
+	// 분 단위(유닛). 분 캔들만 [Integer]
+	Unit int32 `json:"unit"`
+	// 전일 종가(UTC 0시 기준). 일 캔들만 [Double]
+	PrevClosingPrice float64 `json:"prev_closing_price"`
+	// 전일 종가 대비 변화 금액. 일 캔들만 [Double]
+	ChangePrice float64 `json:"change_price"`
+	// 전일 종가 대비 변화량. 일 캔들만 [Double]
+	ChangeRate float64 `json:"change_rate"`
+	// 종가 환산 화폐 단위로 환산된 가격. 일 캔들만 [Double]
+	ConvertedTradePrice float64 `json:"converted_trade_price"`
+	// 캔들 기간의 가장 첫 날 (KST). 주, 월 캔들만 [String]
+	FirstDayOfPeriod time.Time `json:"first_day_of_period"`
+}
+
+// 초(Second) 캔들 @ candles/seconds Block
+type UpbitCandlesSecondsBlock struct {
+	// 마켓명 [String]
+	Market string `json:"market"`
+	// 캔들 기준 시각(UTC 기준) [String]
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
+	// 캔들 기준 시각(KST 기준)	[String]
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
+	// 시가	[Double]
+	OpeningPrice float64 `json:"opening_price"`
+	// 고가	[Double]
+	HighPrice float64 `json:"high_price"`
+	// 저가	[Double]
+	LowPrice float64 `json:"low_price"`
+	// 종가	[Double]
+	TradePrice float64 `json:"trade_price"`
+	// 해당 캔들에서 마지막 틱이 저장된 시각 [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 누적 거래 금액 [Double]
+	CandleAccTradePrice float64 `json:"candle_acc_trade_price"`
+	// 누적 거래량	[Double]
+	CandleAccTradeVolume float64 `json:"candle_acc_trade_volume"`
+}
+
 // 분(Minute) 캔들 @ candles/minutes Block
 type UpbitCandlesMinutesBlock struct {
 	// 마켓명 [String]
 	Market string `json:"market"`
 	// 캔들 기준 시각(UTC 기준) [String]
-	CandleDateTimeUtc string `json:"candle_ddate_time_utc"`
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
 	// 캔들 기준 시각(KST 기준)	[String]
-	CandleDateTimeKst string `json:"candle_date_time_kst"`
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
 	// 시가	[Double]
 	OpeningPrice float64 `json:"opening_price"`
 	// 고가	[Double]
@@ -745,9 +1672,9 @@ type UpbitCandlesDaysBlock struct {
 	// 마켓명 [String]
 	Market string `json:"market"`
 	// 캔들 기준 시각(UTC 기준) [String]
-	CandleDateTimeUtc string `json:"candle_ddate_time_utc"`
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
 	// 캔들 기준 시각(KST 기준)	[String]
-	CandleDateTimeKst string `json:"candle_date_time_kst"`
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
 	// 시가	[Double]
 	OpeningPrice float64 `json:"opening_price"`
 	// 고가	[Double]
@@ -777,9 +1704,35 @@ type UpbitCandlesWeeksBlock struct {
 	// 마켓명 [String]
 	Market string `json:"market"`
 	// 캔들 기준 시각(UTC 기준) [String]
-	CandleDateTimeUtc string `json:"candle_ddate_time_utc"`
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
+	// 캔들 기준 시각(KST 기준)	[String]
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
+	// 시가	[Double]
+	OpeningPrice float64 `json:"opening_price"`
+	// 고가	[Double]
+	HighPrice float64 `json:"high_price"`
+	// 저가	[Double]
+	LowPrice float64 `json:"low_price"`
+	// 종가	[Double]
+	TradePrice float64 `json:"trade_price"`
+	// 마지막 틱이 저장된 시각 [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 누적 거래 금액 [Double]
+	CandleAccTradePrice float64 `json:"candle_acc_trade_price"`
+	// 누적 거래량	[Double]
+	CandleAccTradeVolume float64 `json:"candle_acc_trade_volume"`
+	// 캔들 기간의 가장 첫 날 (KST)	[String]
+	FirstDayOfPeriod time.Time `json:"first_day_of_period"`
+}
+
+// 월(Month) 캔들 @ candles/months Block
+type UpbitCandlesMonthsBlock struct {
+	// 마켓명 [String]
+	Market string `json:"market"`
+	// 캔들 기준 시각(UTC 기준) [String]
+	CandleDateTimeUtc time.Time `json:"candle_date_time_utc"`
 	// 캔들 기준 시각(KST 기준)	[String]
-	CandleDateTimeKst string `json:"candle_date_time_kst"`
+	CandleDateTimeKst time.Time `json:"candle_date_time_kst"`
 	// 시가	[Double]
 	OpeningPrice float64 `json:"opening_price"`
 	// 고가	[Double]
@@ -794,6 +1747,112 @@ type UpbitCandlesWeeksBlock struct {
 	CandleAccTradePrice float64 `json:"candle_acc_trade_price"`
 	// 누적 거래량	[Double]
 	CandleAccTradeVolume float64 `json:"candle_acc_trade_volume"`
-	// 캔들 기간의 가장 첫 날	[String]
-	FirstDayOfPeriod string `json:"first_day_of_period"`
+	// 캔들 기간의 가장 첫 날 (KST)	[String]
+	FirstDayOfPeriod time.Time `json:"first_day_of_period"`
+}
+
+// 최근 체결 내역 @ trades/ticks Block
+type UpbitTradesTicksBlock struct {
+	// 마켓 구분 코드 [String]
+	Market string `json:"market"`
+	// 체결 일자(UTC 기준) [String]
+	TradeDateUtc string `json:"trade_date_utc"`
+	// 체결 시각(UTC 기준) [String]
+	TradeTimeUtc string `json:"trade_time_utc"`
+	// 체결 타임스탬프 [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 체결 가격 [Double]
+	TradePrice float64 `json:"trade_price"`
+	// 체결량 [Double]
+	TradeVolume float64 `json:"trade_volume"`
+	// 전일 종가 [Double]
+	PrevClosingPrice float64 `json:"prev_closing_price"`
+	// 변화량 [Double]
+	ChangePrice float64 `json:"change_price"`
+	// 매도/매수 [String]
+	AskBid string `json:"ask_bid"`
+	// 체결 번호(Unique) [Long]
+	SequentialId int64 `json:"sequential_id"`
+}
+
+// 현재가 정보 @ ticker Block
+type UpbitTickerBlock struct {
+	// 종목 구분 코드 [String]
+	Market string `json:"market"`
+	// 최근 거래 일자(UTC) [String]
+	TradeDate string `json:"trade_date"`
+	// 최근 거래 시각(UTC) [String]
+	TradeTime string `json:"trade_time"`
+	// 최근 거래 일자(KST) [String]
+	TradeDateKst string `json:"trade_date_kst"`
+	// 최근 거래 시각(KST) [String]
+	TradeTimeKst string `json:"trade_time_kst"`
+	// 최근 거래 타임스탬프 [Long]
+	TradeTimestamp int64 `json:"trade_timestamp"`
+	// 시가 [Double]
+	OpeningPrice float64 `json:"opening_price"`
+	// 고가 [Double]
+	HighPrice float64 `json:"high_price"`
+	// 저가 [Double]
+	LowPrice float64 `json:"low_price"`
+	// 종가(현재가) [Double]
+	TradePrice float64 `json:"trade_price"`
+	// 전일 종가(UTC 0시 기준) [Double]
+	PrevClosingPrice float64 `json:"prev_closing_price"`
+	// EVEN(보합), RISE(상승), FALL(하락) [String]
+	Change string `json:"change"`
+	// 변화액의 절대값 [Double]
+	ChangePrice float64 `json:"change_price"`
+	// 변화율의 절대값 [Double]
+	ChangeRate float64 `json:"change_rate"`
+	// 부호가 있는 변화액 [Double]
+	SignedChangePrice float64 `json:"signed_change_price"`
+	// 부호가 있는 변화율 [Double]
+	SignedChangeRate float64 `json:"signed_change_rate"`
+	// 가장 최근 거래량 [Double]
+	TradeVolume float64 `json:"trade_volume"`
+	// 누적 거래대금(UTC 0시 기준) [Double]
+	AccTradePrice float64 `json:"acc_trade_price"`
+	// 24시간 누적 거래대금 [Double]
+	AccTradePrice24h float64 `json:"acc_trade_price_24h"`
+	// 누적 거래량(UTC 0시 기준) [Double]
+	AccTradeVolume float64 `json:"acc_trade_volume"`
+	// 24시간 누적 거래량 [Double]
+	AccTradeVolume24h float64 `json:"acc_trade_volume_24h"`
+	// 52주 신고가 [Double]
+	Highest52WeekPrice float64 `json:"highest_52_week_price"`
+	// 52주 신고가 달성일 [String]
+	Highest52WeekDate string `json:"highest_52_week_date"`
+	// 52주 신저가 [Double]
+	Lowest52WeekPrice float64 `json:"lowest_52_week_price"`
+	// 52주 신저가 달성일 [String]
+	Lowest52WeekDate string `json:"lowest_52_week_date"`
+	// 타임스탬프 [Long]
+	Timestamp int64 `json:"timestamp"`
+}
+
+// 호가 정보 조회 @ orderbook Block
+type UpbitOrderbookBlock struct {
+	// 마켓 코드 [String]
+	Market string `json:"market"`
+	// 호가 생성 시각 [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 호가 매도 총 잔량 [Double]
+	TotalAskSize float64 `json:"total_ask_size"`
+	// 호가 매수 총 잔량 [Double]
+	TotalBidSize float64 `json:"total_bid_size"`
+	// 호가 [List of Objects]
+	OrderbookUnits []OrderbookUnitBlock `json:"orderbook_units"`
+}
+
+// 호가 Block [Object]
+type OrderbookUnitBlock struct {
+	// 매도호가 [Double]
+	AskPrice float64 `json:"ask_price"`
+	// 매수호가 [Double]
+	BidPrice float64 `json:"bid_price"`
+	// 매도 잔량 [Double]
+	AskSize float64 `json:"ask_size"`
+	// 매수 잔량 [Double]
+	BidSize float64 `json:"bid_size"`
 }
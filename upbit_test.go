@@ -1,4 +1,4 @@
-package main
+package yauga_test
 
 /**
  * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
@@ -7,38 +7,112 @@ package main
  * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
  */
 import (
-	"os"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+	"github.com/davidjung-kr/yauga/upbittest"
 )
 
-// 환경변수 상에서 엑세스 데이터 취득
-func getEnvData() (string, string) {
-	yaugaAccessKey := os.Getenv("YAUGA_ACCESS_KEY")
-	yaugaSecrectKey := os.Getenv("YAUGA_SECRECT_KEY")
-	if yaugaAccessKey == "" {
-		panic("Please set a `YAUGA_ACCESS_KEY`")
-	} else if yaugaSecrectKey == "" {
-		panic("Please set a `YAUGA_SECRECT_KEY`")
+// 테스트 기준 시각
+var testNow = time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// 가짜 업비트 서버 준비
+//  계좌, 주문, 마켓, 캔들, 현재가, 호가, 체결 내역을 채워둡니다.
+func newTestServer() *upbittest.Server {
+	server := upbittest.NewServer()
+	server.SetAccounts([]UpbitAccountBlock{
+		{Currency: "KRW", Balance: NewDecimalFromInt(1000000), UnitCurreny: "KRW"},
+		{Currency: "BTC", Balance: MustDecimal("0.00012345"), UnitCurreny: "KRW"},
+	})
+	server.SetChance(UpbitOrdersChanceBlock{
+		BidFee: MustDecimal("0.0005"),
+		AskFee: MustDecimal("0.0005"),
+		Market: MarketBlock{Id: "KRW-BTC", Name: "BTC/KRW", OrderTypes: []string{"limit"}, OrderSides: []string{"ask", "bid"}, State: "active", MaxTotal: MustDecimal("1000000000.0")},
+	})
+	server.SetMarkets([]UpbitMarketAllBlock{
+		{Market: "KRW-BTC", KoreanName: "비트코인", EnglishName: "Bitcoin", MarketWarning: "NONE"},
+		{Market: "KRW-ETH", KoreanName: "이더리움", EnglishName: "Ethereum", MarketWarning: "NONE"},
+	})
+
+	// 최근 1년간 매달 체결/취소된 주문과 미체결 매수 주문
+	for i := 0; i < 12; i++ {
+		state := ORDER_STATE_DONE
+		if i%2 == 1 {
+			state = ORDER_STATE_CANCEL
+		}
+		server.AddOrder(UpbitOrderBlock{Side: "bid", OrdType: "limit", Price: MustDecimal("50000000"), State: string(state), Market: "KRW-BTC", Volume: MustDecimal("0.001"), CreatedAt: testNow.AddDate(0, -i, -1)}, "")
+	}
+	for i := 0; i < 3; i++ {
+		server.AddOrder(UpbitOrderBlock{Side: "bid", OrdType: "limit", Price: MustDecimal("40000000"), State: string(ORDER_STATE_WAIT), Market: "KRW-BTC", Volume: MustDecimal("0.001"), CreatedAt: testNow.Add(-time.Duration(i) * time.Hour)}, fmt.Sprintf("wait-%d", i))
+	}
+	server.AddOrder(UpbitOrderBlock{Side: "ask", OrdType: "limit", Price: MustDecimal("60000000"), State: string(ORDER_STATE_WAIT), Market: "KRW-BTC", Volume: MustDecimal("0.001"), CreatedAt: testNow}, "")
+
+	// 캔들
+	server.SetCandles("KRW-BTC", INTERVAL_MINUTE_1, testCandles(testNow.Add(-6*time.Hour), time.Minute, 7*60))
+	server.SetCandles("KRW-BTC", INTERVAL_SECOND, testCandles(testNow.Add(-time.Minute), time.Second, 60))
+	server.SetCandles("KRW-BTC", INTERVAL_DAY, testCandles(testNow.AddDate(0, 0, -10), 24*time.Hour, 10))
+	server.SetCandles("KRW-BTC", INTERVAL_WEEK, testCandles(testNow.AddDate(0, 0, -70), 7*24*time.Hour, 10))
+	server.SetCandles("KRW-BTC", INTERVAL_MONTH, []Candle{
+		{Market: "KRW-BTC", CandleDateTimeUtc: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), TradePrice: 50000000},
+		{Market: "KRW-BTC", CandleDateTimeUtc: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), TradePrice: 50000000},
+	})
+
+	// 현재가, 호가, 체결 내역
+	for _, market := range []string{"KRW-BTC", "KRW-ETH"} {
+		server.SetTicker(UpbitTickerBlock{Market: market, TradePrice: 50000000})
+		server.SetOrderbook(UpbitOrderbookBlock{Market: market, OrderbookUnits: []OrderbookUnitBlock{{AskPrice: 50001000, BidPrice: 50000000, AskSize: 1, BidSize: 1}}})
+	}
+	var trades []UpbitTradesTicksBlock
+	for i := 1; i <= 1200; i++ {
+		trades = append(trades, UpbitTradesTicksBlock{Market: "KRW-BTC", TradePrice: 50000000, TradeVolume: 0.001, AskBid: "BID", SequentialId: int64(i)})
 	}
-	return yaugaAccessKey, yaugaSecrectKey
+	server.SetTrades("KRW-BTC", trades)
+	return server
+}
+
+// 연속된 테스트 캔들 만들기
+func testCandles(start time.Time, unit time.Duration, count int) []Candle {
+	candles := make([]Candle, 0, count)
+	for i := 0; i < count; i++ {
+		t := start.Add(time.Duration(i) * unit)
+		candles = append(candles, Candle{
+			Market:            "KRW-BTC",
+			CandleDateTimeUtc: t,
+			CandleDateTimeKst: t.In(KST),
+			OpeningPrice:      50000000,
+			HighPrice:         50000000,
+			LowPrice:          50000000,
+			TradePrice:        50000000,
+		})
+	}
+	return candles
 }
 
 // Accounts 테스트
 func TestUpbitAccounts(t *testing.T) {
-	accessKey, secretKey := getEnvData()
-	upbit := NewUpbit(accessKey)
-	upbit.SetSecretKey(secretKey)
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
 	x := upbit.Accounts()
-	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) <= 0 {
-		t.Errorf("TestUpbitAccounts | Status:[%d], accountsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 2 {
+		t.Fatalf("TestUpbitAccounts | Status:[%d], accountsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	if x.Response[1].Currency != "BTC" || x.Response[1].Balance.String() != "0.00012345" {
+		t.Errorf("TestUpbitAccounts | Currency:[%s], Balance:[%s]", x.Response[1].Currency, x.Response[1].Balance)
 	}
 }
 
 // OrdersChance 테스트
 func TestUpbitOrdersChance(t *testing.T) {
-	accessKey, secretKey := getEnvData()
-	upbit := NewUpbit(accessKey)
-	upbit.SetSecretKey(secretKey)
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
 	x := upbit.OrdersChance("KRW", "BTC")
 	if x.Common.StatusCode != 200 || x.Common.Error != nil {
 		t.Errorf("TestUpbitOrdersChance | Status:[%d], OrdersChanceErr:[%s]", x.Common.StatusCode, x.Common.Error)
@@ -47,9 +121,9 @@ func TestUpbitOrdersChance(t *testing.T) {
 
 // Order 테스트
 func TestUpbitOrder(t *testing.T) {
-	accessKey, secretKey := getEnvData()
-	upbit := NewUpbit(accessKey)
-	upbit.SetSecretKey(secretKey)
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
 	x := upbit.Order(OrderOption{Uuid: "TEST"})
 	if x.Common.StatusCode != 404 {
 		t.Errorf("TestUpbitOrder | Status:[%d], OrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
@@ -58,8 +132,9 @@ func TestUpbitOrder(t *testing.T) {
 
 // MarketAll 테스트
 func TestUpbitMarketAll(t *testing.T) {
-	accessKey, _ := getEnvData()
-	upbit := NewUpbit(accessKey)
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
 	x := upbit.MarketAll(true)
 	if x.Common.StatusCode != 200 || x.Common.Error != nil {
 		t.Errorf("TestUpbitMarketAll | Status:[%d], MarketAllErr:[%s]", x.Common.StatusCode, x.Common.Error)
@@ -68,9 +143,10 @@ func TestUpbitMarketAll(t *testing.T) {
 
 // CandlesMinutes 테스트
 func TestUpbitCandlesMinutes(t *testing.T) {
-	accessKey, _ := getEnvData()
-	upbit := NewUpbit(accessKey)
-	x := upbit.CandlesMinutes(1, "KRW-BTC", "", 1)
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesMinutes(1, "KRW-BTC", time.Time{}, 1)
 	if x.Common.StatusCode != 200 || x.Common.Error != nil {
 		t.Errorf("TestUpbitCandlesMinutes | Status:[%d], candlesMinutesErr:[%s]", x.Common.StatusCode, x.Common.Error)
 	}
@@ -78,9 +154,10 @@ func TestUpbitCandlesMinutes(t *testing.T) {
 
 // CandlesDays 테스트
 func TestUpbitCandlesDays(t *testing.T) {
-	accessKey, _ := getEnvData()
-	upbit := NewUpbit(accessKey)
-	x := upbit.CandlesDays("KRW-BTC", "", 1, "KRW")
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesDays("KRW-BTC", time.Time{}, 1, "KRW")
 	if x.Common.StatusCode != 200 || x.Common.Error != nil {
 		t.Errorf("TestUpbitCandlesDays | Status:[%d], candlesDaysErr:[%s]", x.Common.StatusCode, x.Common.Error)
 	}
@@ -88,10 +165,239 @@ func TestUpbitCandlesDays(t *testing.T) {
 
 // CandlesWeeks 테스트
 func TestUpbitCandlesWeeks(t *testing.T) {
-	accessKey, _ := getEnvData()
-	upbit := NewUpbit(accessKey)
-	x := upbit.CandlesWeeks("KRW-BTC", "", 1, "KRW")
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesWeeks("KRW-BTC", time.Time{}, 1, "KRW")
 	if x.Common.StatusCode != 200 || x.Common.Error != nil {
 		t.Errorf("TestUpbitCandlesWeeks | Status:[%d], candlesWeeksErr:[%s]", x.Common.StatusCode, x.Common.Error)
 	}
 }
+
+// PlaceOrder 테스트
+//  잘못된 주문 옵션은 요청 전에 잘못된 필드를 담은 ValidationError 로 걸러져야 함
+func TestUpbitPlaceOrder(t *testing.T) {
+	upbit := NewUpbit("")
+	cases := []struct {
+		opt   PlaceOrderOption
+		field string
+	}{
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "10000"}, "Volume"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_ASK, OrdType: ORDER_TYPE_PRICE, Price: "10000"}, "Side"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_BEST, Price: "10000"}, "TimeInForce"},
+		{PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_ASK, OrdType: ORDER_TYPE_MARKET, Volume: "1", Price: "10000"}, "Price"},
+	}
+	for _, c := range cases {
+		x := upbit.PlaceOrder(c.opt)
+		var validationErr *ValidationError
+		if x.Common.StatusCode != 0 || !errors.As(x.Common.Error, &validationErr) || validationErr.Field != c.field {
+			t.Errorf("TestUpbitPlaceOrder | Status:[%d], PlaceOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+		}
+	}
+}
+
+// 잘못된 파라미터 테스트
+//  panic 없이 ValidationError 를 반환해야 함
+func TestUpbitValidationError(t *testing.T) {
+	upbit := NewUpbit("")
+	var validationErr *ValidationError
+	if x := upbit.Order(OrderOption{}); !errors.As(x.Common.Error, &validationErr) || validationErr.Field != "Uuid" {
+		t.Errorf("TestUpbitValidationError | OrderErr:[%s]", x.Common.Error)
+	}
+	if x := upbit.CandlesMinutes(2, "KRW-BTC", time.Time{}, 1); !errors.As(x.Common.Error, &validationErr) || validationErr.Field != "Unit" {
+		t.Errorf("TestUpbitValidationError | candlesMinutesErr:[%s]", x.Common.Error)
+	}
+	if x := upbit.CandlesMinutes(1, "KRW-BTC", time.Time{}, 201); !errors.As(x.Common.Error, &validationErr) || validationErr.Field != "Count" {
+		t.Errorf("TestUpbitValidationError | candlesMinutesErr:[%s]", x.Common.Error)
+	}
+	if x := upbit.Accounts(); !errors.As(x.Common.Error, &validationErr) || validationErr.Field != "AccessKey" {
+		t.Errorf("TestUpbitValidationError | accountsErr:[%s]", x.Common.Error)
+	}
+	upbit = NewUpbit("access-key")
+	if x := upbit.OrdersChance("KRW", "BTC"); !errors.As(x.Common.Error, &validationErr) || validationErr.Field != "SecretKey" {
+		t.Errorf("TestUpbitValidationError | OrdersChanceErr:[%s]", x.Common.Error)
+	}
+}
+
+// CancelOrder 테스트
+func TestUpbitCancelOrder(t *testing.T) {
+	upbit := NewUpbit("")
+	x := upbit.CancelOrder(OrderOption{})
+	if x.Common.StatusCode != 0 || x.Common.Error == nil {
+		t.Errorf("TestUpbitCancelOrder | Status:[%d], CancelOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// CancelOpenOrders 테스트
+func TestUpbitCancelOpenOrders(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CancelOpenOrders("KRW-BTC", ORDER_SIDE_BID)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 3 {
+		t.Errorf("TestUpbitCancelOpenOrders | Status:[%d], CancelOpenOrdersErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	for _, v := range x.Response {
+		if v.Result.Common.Error != nil {
+			t.Errorf("TestUpbitCancelOpenOrders | Uuid:[%s], CancelOrderErr:[%s]", v.Uuid, v.Result.Common.Error)
+		}
+	}
+}
+
+// Orders 테스트
+func TestUpbitOrders(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.Orders(OrdersOption{Market: "KRW-BTC", States: []OrderState{ORDER_STATE_DONE, ORDER_STATE_CANCEL}, Limit: 10})
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 10 {
+		t.Errorf("TestUpbitOrders | Status:[%d], OrdersErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	x = upbit.Orders(OrdersOption{Market: "KRW-BTC", Limit: 101})
+	if x.Common.StatusCode != 0 || x.Common.Error == nil {
+		t.Errorf("TestUpbitOrders | Status:[%d], OrdersErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// TradesTicks 테스트
+func TestUpbitTradesTicks(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.TradesTicks("KRW-BTC", "", 5, "", 0)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil {
+		t.Errorf("TestUpbitTradesTicks | Status:[%d], tradesTicksErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// Ticker 테스트
+func TestUpbitTicker(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.Ticker([]string{"KRW-BTC", "KRW-ETH"})
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 2 {
+		t.Errorf("TestUpbitTicker | Status:[%d], tickerErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// Orderbook 테스트
+func TestUpbitOrderbook(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.Orderbook([]string{"KRW-BTC", "KRW-ETH"})
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 2 {
+		t.Errorf("TestUpbitOrderbook | Status:[%d], orderbookErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// CandlesSeconds 테스트
+func TestUpbitCandlesSeconds(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesSeconds("KRW-BTC", time.Time{}, 1)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil {
+		t.Errorf("TestUpbitCandlesSeconds | Status:[%d], candlesSecondsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// CandlesMonths 테스트
+func TestUpbitCandlesMonths(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	x := upbit.CandlesMonths("KRW-BTC", time.Time{}, 1, "")
+	if x.Common.StatusCode != 200 || x.Common.Error != nil {
+		t.Errorf("TestUpbitCandlesMonths | Status:[%d], candlesMonthsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// Candles 테스트
+func TestUpbitCandles(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	upbit := server.NewUpbit()
+	for _, interval := range []Interval{INTERVAL_SECOND, INTERVAL_MINUTE_1, INTERVAL_DAY, INTERVAL_WEEK, INTERVAL_MONTH} {
+		x := upbit.Candles("KRW-BTC", interval, time.Time{}, 2)
+		if x.Common.StatusCode != 200 || x.Common.Error != nil || len(x.Response) != 2 {
+			t.Errorf("TestUpbitCandles | Interval:[%s], Status:[%d], candlesErr:[%s]", interval, x.Common.StatusCode, x.Common.Error)
+		}
+	}
+	x := upbit.Candles("KRW-BTC", Interval("minutes/2"), time.Time{}, 1)
+	if x.Common.StatusCode != 0 || x.Common.Error == nil {
+		t.Errorf("TestUpbitCandles | Status:[%d], candlesErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// APIError 테스트
+//  오류 응답의 종류를 errors.Is 로, 내용을 errors.As 로 확인할 수 있어야 함
+func TestUpbitAPIError(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	server.FailNext("/v1/orders", 1, http.StatusBadRequest, "insufficient_funds_bid", "주문가능한 금액(KRW)이 부족합니다.")
+	server.FailNext("/v1/ticker", 1, http.StatusServiceUnavailable, "service_unavailable", "")
+
+	upbit := server.NewUpbit(WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	x := upbit.PlaceOrder(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Volume: "1", Price: "50000000"})
+	var apiErr *APIError
+	if !errors.Is(x.Common.Error, ErrInsufficientFundsBid) || errors.Is(x.Common.Error, ErrInsufficientFundsAsk) || !errors.As(x.Common.Error, &apiErr) || apiErr.StatusCode != 400 || len(apiErr.Body) <= 0 {
+		t.Errorf("TestUpbitAPIError | Status:[%d], PlaceOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	y := upbit.Ticker([]string{"KRW-BTC"})
+	if !errors.Is(y.Common.Error, ErrServerError) {
+		t.Errorf("TestUpbitAPIError | Status:[%d], tickerErr:[%s]", y.Common.StatusCode, y.Common.Error)
+	}
+	if err := ParseAPIError(429, []byte("Too many API requests.")); !errors.Is(err, ErrTooManyRequests) || err.Message != "Too many API requests." {
+		t.Errorf("TestUpbitAPIError | apiErr:[%s]", err)
+	}
+}
+
+// ClientOption, context 테스트
+//  지정한 HTTP 클라이언트, 호스트, User-Agent 로 요청하고 ctx 시간 제한을 지켜야 함
+func TestUpbitClientOption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "yauga-test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("markets") == "KRW-SLOW" {
+			time.Sleep(500 * time.Millisecond)
+		}
+		w.Write([]byte(`[{"market":"KRW-BTC","trade_price":50000000.0}]`))
+	}))
+	defer server.Close()
+
+	upbit := NewUpbit("", WithHTTPClient(server.Client()), WithBaseURL(server.URL), WithUserAgent("yauga-test"))
+	x := upbit.Ticker([]string{"KRW-BTC"})
+	if x.Common.StatusCode != 200 || x.Common.Error != nil || x.Response[0].TradePrice != 50000000 {
+		t.Errorf("TestUpbitClientOption | Status:[%d], tickerErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	x = upbit.TickerContext(ctx, []string{"KRW-SLOW"})
+	if !errors.Is(x.Common.Error, context.DeadlineExceeded) {
+		t.Errorf("TestUpbitClientOption | Status:[%d], tickerErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// ResolveURL 테스트
+func TestUpbitResolveURL(t *testing.T) {
+	upbit := NewUpbit("")
+	if x := upbit.ResolveURL(UPBIT_URL_ACCOUNTS); x != UPBIT_URL_ACCOUNTS {
+		t.Errorf("TestUpbitResolveURL | URL:[%s]", x)
+	}
+	upbit.SetBaseURL(UPBIT_HOST_SG + "/")
+	if x := upbit.ResolveURL(UPBIT_URL_ACCOUNTS); x != "https://sg-api.upbit.com/v1/accounts" {
+		t.Errorf("TestUpbitResolveURL | URL:[%s]", x)
+	}
+	if x := upbit.ResolveURL(UPBIT_WEBSOCKET_HOST + "/websocket/v1"); x != "wss://sg-api.upbit.com/websocket/v1" {
+		t.Errorf("TestUpbitResolveURL | URL:[%s]", x)
+	}
+	upbit.SetBaseURL("http://127.0.0.1:8080")
+	if x := upbit.ResolveURL(UPBIT_WEBSOCKET_HOST + "/websocket/v1"); x != "ws://127.0.0.1:8080/websocket/v1" {
+		t.Errorf("TestUpbitResolveURL | URL:[%s]", x)
+	}
+}
@@ -0,0 +1,776 @@
+package upbittest
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+const (
+	// 기본 access key
+	ACCESS_KEY = "upbittest-access-key"
+	// 기본 secret key
+	SECRET_KEY = "upbittest-secret-key"
+	// 응답에 붙이는 Remaining-Req 헤더의 min
+	REMAINING_REQ_MIN = 1800
+)
+
+// 가짜 업비트 서버
+//  httptest.Server 위에서 accounts, orders/chance, order, orders, market/all, candles 를 흉내냅니다.
+//	인증이 필요한 요청은 업비트와 같은 방식으로 JWT 서명과 query_hash 를 검사합니다.
+//	Set*, Add* 로 상태를 꾸미고 FailNext 로 오류 응답을 주입할 수 있습니다.
+type Server struct {
+	*httptest.Server
+	// 인증에 사용할 access key
+	AccessKey string
+	// 인증에 사용할 secret key
+	SecretKey string
+
+	mu       sync.Mutex
+	accounts []yauga.UpbitAccountBlock
+	chances  map[string]yauga.UpbitOrdersChanceBlock
+	orders   []order
+	markets  []yauga.UpbitMarketAllBlock
+	candles  map[string][]yauga.Candle
+	tickers  map[string]yauga.UpbitTickerBlock
+	books    map[string]yauga.UpbitOrderbookBlock
+	trades   map[string][]yauga.UpbitTradesTicksBlock
+	nonces   map[string]bool
+	failures []failure
+	requests []string
+}
+
+// 주문과 identifier
+type order struct {
+	block      yauga.UpbitOrderBlock
+	identifier string
+}
+
+// 주입한 오류 응답
+type failure struct {
+	path    string
+	count   int
+	status  int
+	name    string
+	message string
+	// 요청을 처리한 뒤 응답만 오류로 바꿀지 여부
+	lost bool
+}
+
+// Initialization
+//  테스트가 끝나면 Close 하세요.
+func NewServer() *Server {
+	s := &Server{
+		AccessKey: ACCESS_KEY,
+		SecretKey: SECRET_KEY,
+		chances:   map[string]yauga.UpbitOrdersChanceBlock{},
+		candles:   map[string][]yauga.Candle{},
+		tickers:   map[string]yauga.UpbitTickerBlock{},
+		books:     map[string]yauga.UpbitOrderbookBlock{},
+		trades:    map[string][]yauga.UpbitTradesTicksBlock{},
+		nonces:    map[string]bool{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// 이 서버를 바라보는 Upbit 만들기
+//  access key, secret key, API 호스트, HTTP 클라이언트가 세팅되어 있습니다.
+func (s *Server) NewUpbit(opts ...yauga.ClientOption) *yauga.Upbit {
+	opts = append([]yauga.ClientOption{yauga.WithBaseURL(s.URL), yauga.WithHTTPClient(s.Client())}, opts...)
+	upbit := yauga.NewUpbit(s.AccessKey, opts...)
+	upbit.SetSecretKey(s.SecretKey)
+	return upbit
+}
+
+// 계좌 세팅 @ accounts
+func (s *Server) SetAccounts(accounts []yauga.UpbitAccountBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts = append([]yauga.UpbitAccountBlock{}, accounts...)
+}
+
+// 주문 가능 정보 세팅 @ orders/chance
+func (s *Server) SetChance(chance yauga.UpbitOrdersChanceBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chances[chance.Market.Id] = chance
+}
+
+// 주문 추가 @ order, orders
+//  Uuid 가 비어 있으면 새로 만듭니다.
+// Params:
+//	block = 주문 Block
+//	identifier = 조회용 사용자 지정 값 (없으면 "")
+func (s *Server) AddOrder(block yauga.UpbitOrderBlock, identifier string) yauga.UpbitOrderBlock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if block.Uuid == "" {
+		block.Uuid = uuid.New().String()
+	}
+	s.orders = append(s.orders, order{block: block, identifier: identifier})
+	return block
+}
+
+// 주문 상태 바꾸기
+//  fn 으로 주문을 고칩니다. 주문이 없으면 false 를 반환합니다.
+func (s *Server) UpdateOrder(uuid string, fn func(order *yauga.UpbitOrderBlock)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.orders {
+		if s.orders[i].block.Uuid == uuid {
+			fn(&s.orders[i].block)
+			return true
+		}
+	}
+	return false
+}
+
+// 전체 주문 취득
+func (s *Server) Orders() []yauga.UpbitOrderBlock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orders := make([]yauga.UpbitOrderBlock, 0, len(s.orders))
+	for _, o := range s.orders {
+		orders = append(orders, o.block)
+	}
+	return orders
+}
+
+// 마켓 코드 세팅 @ market/all
+func (s *Server) SetMarkets(markets []yauga.UpbitMarketAllBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.markets = append([]yauga.UpbitMarketAllBlock{}, markets...)
+}
+
+// 캔들 세팅 @ candles/{interval}
+//  순서와 상관없이 넣으면 최신 캔들부터 정렬해 둡니다.
+func (s *Server) SetCandles(market string, interval yauga.Interval, candles []yauga.Candle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sorted := append([]yauga.Candle{}, candles...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CandleDateTimeUtc.After(sorted[j].CandleDateTimeUtc)
+	})
+	s.candles[string(interval)+"|"+market] = sorted
+}
+
+// 현재가 세팅 @ ticker
+func (s *Server) SetTicker(ticker yauga.UpbitTickerBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tickers[ticker.Market] = ticker
+}
+
+// 호가 세팅 @ orderbook
+func (s *Server) SetOrderbook(orderbook yauga.UpbitOrderbookBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.books[orderbook.Market] = orderbook
+}
+
+// 체결 내역 세팅 @ trades/ticks
+//  순서와 상관없이 넣으면 최신 체결(sequential_id 내림차순)부터 정렬해 둡니다.
+func (s *Server) SetTrades(market string, trades []yauga.UpbitTradesTicksBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sorted := append([]yauga.UpbitTradesTicksBlock{}, trades...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].SequentialId > sorted[j].SequentialId
+	})
+	s.trades[market] = sorted
+}
+
+// 오류 응답 주입
+//  path 로 들어오는 다음 count 번의 요청에 status 와 업비트 오류 Block 으로 응답합니다.
+//	path 를 비우면 모든 요청에 적용됩니다. 429 는 Remaining-Req 의 sec 를 0 으로 보냅니다.
+// Params:
+//	path = 요청 경로 (ex. /v1/accounts)
+//	count = 실패시킬 요청 수
+//	status = HTTP Status code (ex. 429, 500)
+//	name = 업비트 오류 이름 (ex. too_many_requests)
+//	message = 업비트 오류 메시지
+func (s *Server) FailNext(path string, count int, status int, name string, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{path: path, count: count, status: status, name: name, message: message})
+}
+
+// 응답 유실 주입
+//  path 로 들어오는 다음 count 번의 요청을 정상 처리한 뒤 응답만 status 오류로 바꿉니다.
+//	주문이 접수됐지만 응답을 받지 못한 상황(ex. 게이트웨이 타임아웃)을 흉내 냅니다.
+// Params:
+//	path = 요청 경로 (ex. /v1/orders)
+//	count = 응답을 잃을 요청 수
+//	status = HTTP Status code (ex. 504)
+func (s *Server) LoseNext(path string, count int, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{path: path, count: count, status: status, name: "gateway_timeout", message: "응답 유실", lost: true})
+}
+
+// 받은 요청 목록
+//  "METHOD /path?query" 형태입니다.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// 요청 처리
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	line := r.Method + " " + r.URL.Path
+	if r.URL.RawQuery != "" {
+		line += "?" + r.URL.RawQuery
+	}
+	s.requests = append(s.requests, line)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	group, sec := remainingReqGroup(r.Method, r.URL.Path)
+	w.Header().Set("Remaining-Req", fmt.Sprintf("group=%s; min=%d; sec=%d", group, REMAINING_REQ_MIN, sec))
+	for i := range s.failures {
+		f := &s.failures[i]
+		if f.count > 0 && (f.path == "" || f.path == r.URL.Path) {
+			f.count--
+			if f.status == http.StatusTooManyRequests {
+				w.Header().Set("Remaining-Req", fmt.Sprintf("group=%s; min=%d; sec=0", group, REMAINING_REQ_MIN-1))
+			}
+			if f.lost {
+				s.route(httptest.NewRecorder(), r, body)
+			}
+			writeError(w, f.status, f.name, f.message)
+			return
+		}
+	}
+	s.route(w, r, body)
+}
+
+// 경로별 처리
+func (s *Server) route(w http.ResponseWriter, r *http.Request, body []byte) {
+	path := r.URL.Path
+	switch {
+	case path == "/v1/accounts" && r.Method == http.MethodGet:
+		if s.authorizeQuery(w, r) {
+			writeJSON(w, http.StatusOK, s.accounts)
+		}
+	case path == "/v1/orders/chance" && r.Method == http.MethodGet:
+		if s.authorizeQuery(w, r) {
+			s.serveChance(w, r.URL.Query())
+		}
+	case path == "/v1/order" && r.Method == http.MethodGet:
+		if s.authorizeQuery(w, r) {
+			s.serveOrder(w, r.URL.Query(), false)
+		}
+	case path == "/v1/order" && r.Method == http.MethodDelete:
+		if s.authorizeQuery(w, r) {
+			s.serveOrder(w, r.URL.Query(), true)
+		}
+	case path == "/v1/orders" && r.Method == http.MethodGet:
+		if s.authorizeQuery(w, r) {
+			s.serveOrders(w, r.URL.Query())
+		}
+	case path == "/v1/orders" && r.Method == http.MethodPost:
+		params, err := bodyParams(body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		if s.authorize(w, r, hashString(params)) {
+			s.servePlaceOrder(w, paramValues(params))
+		}
+	case path == "/v1/market/all" && r.Method == http.MethodGet:
+		s.serveMarketAll(w, r.URL.Query())
+	case path == "/v1/ticker" && r.Method == http.MethodGet:
+		s.serveTicker(w, r.URL.Query())
+	case path == "/v1/orderbook" && r.Method == http.MethodGet:
+		s.serveOrderbook(w, r.URL.Query())
+	case path == "/v1/trades/ticks" && r.Method == http.MethodGet:
+		s.serveTradesTicks(w, r.URL.Query())
+	case strings.HasPrefix(path, "/v1/candles/") && r.Method == http.MethodGet:
+		s.serveCandles(w, yauga.Interval(strings.TrimPrefix(path, "/v1/candles/")), r.URL.Query())
+	default:
+		writeError(w, http.StatusNotFound, "not_found", "Not Found")
+	}
+}
+
+// 요청 경로의 Remaining-Req 그룹과 sec
+//  업비트와 같은 그룹 이름을 쓰며, sec 는 그룹 최대 요청 수보다 하나 적은 값입니다.
+func remainingReqGroup(method string, path string) (string, int) {
+	switch {
+	case method == http.MethodPost && path == "/v1/orders":
+		return "order", 7
+	case strings.HasPrefix(path, "/v1/market/"):
+		return "market", 9
+	case strings.HasPrefix(path, "/v1/candles/"):
+		return "candles", 9
+	case strings.HasPrefix(path, "/v1/trades/"):
+		return "crix-trades", 9
+	case path == "/v1/ticker":
+		return "ticker", 9
+	case path == "/v1/orderbook":
+		return "orderbook", 9
+	default:
+		return "default", 29
+	}
+}
+
+// 쿼리 문자열 요청의 JWT 인증 검사
+//  받은 쿼리 문자열의 파라미터 순서 그대로 query_hash 를 계산합니다.
+func (s *Server) authorizeQuery(w http.ResponseWriter, r *http.Request) bool {
+	params, err := queryParams(r.URL.RawQuery)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return false
+	}
+	return s.authorize(w, r, hashString(params))
+}
+
+// JWT 인증 검사
+//  서명, access_key, nonce 재사용, query_hash 를 업비트와 같이 검사합니다.
+//	실패하면 401 로 응답하고 false 를 반환합니다.
+// Params:
+//	query = query_hash 를 계산할 문자열 (hashString)
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, query string) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "jwt_verification", "Authorization header is missing.")
+		return false
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(header, "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return []byte(s.SecretKey), nil
+	})
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "jwt_verification", "Failed to verify Jwt token.")
+		return false
+	}
+	if claims["access_key"] != s.AccessKey {
+		writeError(w, http.StatusUnauthorized, "invalid_access_key", "Invalid access key.")
+		return false
+	}
+	nonce, _ := claims["nonce"].(string)
+	if nonce == "" || s.nonces[nonce] {
+		writeError(w, http.StatusUnauthorized, "nonce_used", "Nonce is already used.")
+		return false
+	}
+	s.nonces[nonce] = true
+
+	queryHash, _ := claims["query_hash"].(string)
+	if query == "" && queryHash == "" {
+		return true
+	}
+	if claims["query_hash_alg"] != "SHA512" || queryHash != fmt.Sprintf("%x", sha512.Sum512([]byte(query))) {
+		writeError(w, http.StatusUnauthorized, "invalid_query_payload", "Query payload is invalid.")
+		return false
+	}
+	return true
+}
+
+// 주문 가능 정보 @ orders/chance
+func (s *Server) serveChance(w http.ResponseWriter, query url.Values) {
+	chance, ok := s.chances[query.Get("market")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "market_does_not_exist", "Market does not exist.")
+		return
+	}
+	writeJSON(w, http.StatusOK, chance)
+}
+
+// 개별 주문 조회, 주문 취소 접수 @ order
+func (s *Server) serveOrder(w http.ResponseWriter, query url.Values, cancel bool) {
+	index := s.findOrder(query.Get("uuid"), query.Get("identifier"))
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "order_not_found", "주문을 찾지 못했습니다.")
+		return
+	}
+	if cancel {
+		order := &s.orders[index].block
+		if order.State != string(yauga.ORDER_STATE_WAIT) && order.State != string(yauga.ORDER_STATE_WATCH) {
+			writeError(w, http.StatusBadRequest, "order_not_found", "이미 체결되었거나 취소된 주문입니다.")
+			return
+		}
+		order.State = string(yauga.ORDER_STATE_CANCEL)
+	}
+	writeJSON(w, http.StatusOK, s.orders[index].block)
+}
+
+// 주문 찾기
+func (s *Server) findOrder(uuid string, identifier string) int {
+	for i, o := range s.orders {
+		if (uuid != "" && o.block.Uuid == uuid) || (uuid == "" && identifier != "" && o.identifier == identifier) {
+			return i
+		}
+	}
+	return -1
+}
+
+// 주문 리스트 조회 @ orders
+func (s *Server) serveOrders(w http.ResponseWriter, query url.Values) {
+	states := query["states[]"]
+	if state := query.Get("state"); state != "" {
+		states = []string{state}
+	} else if len(states) <= 0 {
+		states = []string{string(yauga.ORDER_STATE_WAIT)}
+	}
+	uuids := query["uuids[]"]
+	identifiers := query["identifiers[]"]
+
+	var matched []yauga.UpbitOrderBlock
+	for _, o := range s.orders {
+		order := o.block
+		if market := query.Get("market"); market != "" && order.Market != market {
+			continue
+		}
+		if !contains(states, order.State) {
+			continue
+		}
+		if len(uuids) > 0 && !contains(uuids, order.Uuid) {
+			continue
+		}
+		if len(identifiers) > 0 && !contains(identifiers, o.identifier) {
+			continue
+		}
+		matched = append(matched, order)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if query.Get("order_by") == "asc" {
+			return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		return matched[i].CreatedAt.After(matched[j].CreatedAt)
+	})
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit <= 0 {
+		limit = 100
+	}
+	start := (page - 1) * limit
+	if start > len(matched) {
+		start = len(matched)
+	}
+	end := start + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	writeJSON(w, http.StatusOK, append([]yauga.UpbitOrderBlock{}, matched[start:end]...))
+}
+
+// 주문하기 @ orders
+//  주문은 wait 상태로 추가되며 체결은 UpdateOrder 로 흉내냅니다.
+func (s *Server) servePlaceOrder(w http.ResponseWriter, params url.Values) {
+	side := params.Get("side")
+	ordType := params.Get("ord_type")
+	volumeParam := params.Get("volume")
+	priceParam := params.Get("price")
+	if params.Get("market") == "" || (side != "bid" && side != "ask") {
+		writeError(w, http.StatusBadRequest, "validation_error", "잘못된 파라미터입니다.")
+		return
+	}
+	if (ordType == "limit" && (volumeParam == "" || priceParam == "")) || (ordType == "price" && priceParam == "") || (ordType == "market" && volumeParam == "") {
+		writeError(w, http.StatusBadRequest, "validation_error", "잘못된 파라미터입니다.")
+		return
+	}
+	price, priceOk := decimalParam(priceParam)
+	volume, volumeOk := decimalParam(volumeParam)
+	if !priceOk || !volumeOk {
+		writeError(w, http.StatusBadRequest, "validation_error", "잘못된 파라미터입니다.")
+		return
+	}
+	identifier := params.Get("identifier")
+	if identifier != "" && s.findOrder("", identifier) >= 0 {
+		writeError(w, http.StatusBadRequest, "duplicated_identifier", "중복된 identifier 입니다.")
+		return
+	}
+
+	zero := yauga.NewDecimalFromInt(0)
+	block := yauga.UpbitOrderBlock{
+		Uuid:            uuid.New().String(),
+		Side:            side,
+		OrdType:         ordType,
+		Price:           price,
+		State:           string(yauga.ORDER_STATE_WAIT),
+		Market:          params.Get("market"),
+		CreatedAt:       time.Now().In(yauga.KST),
+		Volume:          volume,
+		RemainingVolume: volume,
+		ReservedFee:     zero,
+		RemainingFee:    zero,
+		PaidFee:         zero,
+		Locked:          zero,
+		ExecutedVolume:  zero,
+	}
+	s.orders = append(s.orders, order{block: block, identifier: identifier})
+	writeJSON(w, http.StatusCreated, block)
+}
+
+// 마켓 코드 조회 @ market/all
+func (s *Server) serveMarketAll(w http.ResponseWriter, query url.Values) {
+	markets := append([]yauga.UpbitMarketAllBlock{}, s.markets...)
+	if query.Get("isDetails") != "true" {
+		for i := range markets {
+			markets[i].MarketWarning = ""
+		}
+	}
+	writeJSON(w, http.StatusOK, markets)
+}
+
+// 현재가 조회 @ ticker
+func (s *Server) serveTicker(w http.ResponseWriter, query url.Values) {
+	tickers := []yauga.UpbitTickerBlock{}
+	for _, market := range strings.Split(query.Get("markets"), ",") {
+		ticker, ok := s.tickers[market]
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", "Code not found")
+			return
+		}
+		tickers = append(tickers, ticker)
+	}
+	writeJSON(w, http.StatusOK, tickers)
+}
+
+// 호가 조회 @ orderbook
+func (s *Server) serveOrderbook(w http.ResponseWriter, query url.Values) {
+	books := []yauga.UpbitOrderbookBlock{}
+	for _, market := range strings.Split(query.Get("markets"), ",") {
+		if book, ok := s.books[market]; ok {
+			books = append(books, book)
+		}
+	}
+	writeJSON(w, http.StatusOK, books)
+}
+
+// 최근 체결 내역 @ trades/ticks
+//  cursor(sequential_id) 보다 이전 체결을 최신 체결부터 count 개 반환합니다.
+func (s *Server) serveTradesTicks(w http.ResponseWriter, query url.Values) {
+	count := 1
+	if v := query.Get("count"); v != "" {
+		count, _ = strconv.Atoi(v)
+	}
+	cursor, _ := strconv.ParseInt(query.Get("cursor"), 10, 64)
+
+	trades := []yauga.UpbitTradesTicksBlock{}
+	for _, trade := range s.trades[query.Get("market")] {
+		if len(trades) >= count {
+			break
+		}
+		if cursor > 0 && trade.SequentialId >= cursor {
+			continue
+		}
+		trades = append(trades, trade)
+	}
+	writeJSON(w, http.StatusOK, trades)
+}
+
+// 캔들 조회 @ candles/{interval}
+//  to 보다 이전 캔들을 최신 캔들부터 count 개 반환합니다.
+func (s *Server) serveCandles(w http.ResponseWriter, interval yauga.Interval, query url.Values) {
+	count := 1
+	if v := query.Get("count"); v != "" {
+		count, _ = strconv.Atoi(v)
+	}
+	if count <= 0 || count > 200 {
+		writeError(w, http.StatusBadRequest, "validation_error", "count 는 1 ~ 200 사이여야 합니다.")
+		return
+	}
+	var to time.Time
+	if v := query.Get("to"); v != "" {
+		var err error
+		to, err = parseTo(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+	}
+
+	candles := []map[string]interface{}{}
+	for _, candle := range s.candles[string(interval)+"|"+query.Get("market")] {
+		if len(candles) >= count {
+			break
+		}
+		if !to.IsZero() && !candle.CandleDateTimeUtc.Before(to) {
+			continue
+		}
+		candles = append(candles, candleWire(candle))
+	}
+	writeJSON(w, http.StatusOK, candles)
+}
+
+// 업비트 응답 형식의 캔들
+//  candle_date_time_utc, candle_date_time_kst 는 시간대 없는 yyyy-MM-dd'T'HH:mm:ss, first_day_of_period 는 yyyy-MM-dd 입니다.
+//	KST 시각이 비어 있으면 UTC 시각으로 채우고, 기간 첫 날이 비어 있으면 보내지 않습니다.
+func candleWire(candle yauga.Candle) map[string]interface{} {
+	wire := map[string]interface{}{}
+	encoded, _ := json.Marshal(candle)
+	json.Unmarshal(encoded, &wire)
+
+	kst := candle.CandleDateTimeKst
+	if kst.IsZero() {
+		kst = candle.CandleDateTimeUtc
+	}
+	wire["candle_date_time_utc"] = candle.CandleDateTimeUtc.UTC().Format(yauga.CANDLE_DATE_TIME_FORMAT)
+	wire["candle_date_time_kst"] = kst.In(yauga.KST).Format(yauga.CANDLE_DATE_TIME_FORMAT)
+	if candle.FirstDayOfPeriod.IsZero() {
+		delete(wire, "first_day_of_period")
+	} else {
+		wire["first_day_of_period"] = candle.FirstDayOfPeriod.In(yauga.KST).Format("2006-01-02")
+	}
+	return wire
+}
+
+// 캔들 to 파라미터 해석
+//  yyyy-MM-dd'T'HH:mm:ss'Z' 또는 yyyy-MM-dd HH:mm:ss (UTC)
+func parseTo(v string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, yauga.CANDLE_DATE_TIME_FORMAT, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid to: %s", v)
+}
+
+// 요청 파라미터 (받은 순서 유지)
+type param struct {
+	key   string
+	value string
+}
+
+// 쿼리 문자열 파라미터 (받은 순서대로)
+func queryParams(rawQuery string) ([]param, error) {
+	params := []param{}
+	for _, part := range strings.Split(rawQuery, "&") {
+		if part == "" {
+			continue
+		}
+		key, value := part, ""
+		if i := strings.IndexByte(part, '='); i >= 0 {
+			key, value = part[:i], part[i+1:]
+		}
+		var err error
+		if key, err = url.QueryUnescape(key); err != nil {
+			return nil, err
+		}
+		if value, err = url.QueryUnescape(value); err != nil {
+			return nil, err
+		}
+		params = append(params, param{key: key, value: value})
+	}
+	return params, nil
+}
+
+// JSON 본문 파라미터 (받은 순서대로)
+//  배열 값은 key[] 로 바꿉니다.
+func bodyParams(body []byte) ([]param, error) {
+	params := []param{}
+	if len(bytes.TrimSpace(body)) <= 0 {
+		return params, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, fmt.Errorf("body must be a JSON object")
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := token.(string)
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		switch value := value.(type) {
+		case []interface{}:
+			for _, item := range value {
+				params = append(params, param{key: key + "[]", value: fmt.Sprint(item)})
+			}
+		case nil:
+			params = append(params, param{key: key})
+		default:
+			params = append(params, param{key: key, value: fmt.Sprint(value)})
+		}
+	}
+	return params, nil
+}
+
+// query_hash 를 계산할 문자열
+//  업비트와 같이 받은 순서대로 unquote(urlencode(params)) 한 문자열입니다.
+//	urlencode 가 공백만 "+" 로 바꾸고 unquote 는 "+" 를 되돌리지 않으므로, 공백만 "+" 로 바뀐 원래 값이 됩니다.
+func hashString(params []param) string {
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		parts = append(parts, strings.ReplaceAll(p.key, " ", "+")+"="+strings.ReplaceAll(p.value, " ", "+"))
+	}
+	return strings.Join(parts, "&")
+}
+
+// 파라미터를 url.Values 로 바꾸기
+func paramValues(params []param) url.Values {
+	values := url.Values{}
+	for _, p := range params {
+		values.Add(p.key, p.value)
+	}
+	return values
+}
+
+// 문자열 목록에 포함되어 있는지 확인
+func contains(list []string, target string) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// 금액, 수량 파라미터 해석
+//  비어 있으면 영값, 0 이하이거나 숫자가 아니면 ok 가 false 입니다.
+func decimalParam(v string) (yauga.Decimal, bool) {
+	if v == "" {
+		return yauga.Decimal{}, true
+	}
+	d, err := yauga.ParseDecimal(v)
+	return d, err == nil && d.Sign() > 0
+}
+
+// JSON 응답
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// 업비트 오류 응답
+func writeError(w http.ResponseWriter, status int, name string, message string) {
+	writeJSON(w, status, yauga.UpbitErrorResponse{ErrorBlock: yauga.UpbitErrorBlock{Name: name, Message: message}})
+}
@@ -0,0 +1,155 @@
+package upbittest
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+	"github.com/golang-jwt/jwt"
+)
+
+// 인증 검사 테스트
+//  secret key 가 다르면 jwt_verification, query_hash 가 다르면 (인코딩한 문자열로 만든 해시 포함) invalid_query_payload 로 거절해야 함
+func TestUpbitServerAuthorize(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	upbit := server.NewUpbit()
+	x := upbit.Accounts()
+	if x.Common.StatusCode != 200 || x.Common.Error != nil {
+		t.Errorf("TestUpbitServerAuthorize | Status:[%d], accountsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+
+	upbit.SetSecretKey("wrong-secret-key")
+	x = upbit.Accounts()
+	if x.Common.StatusCode != 401 || x.Common.Error == nil || !errors.Is(x.Common.Error, yauga.ErrJwtVerification) {
+		t.Errorf("TestUpbitServerAuthorize | Status:[%d], accountsErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+
+	// 인코딩되는 문자(:, +, 공백)가 든 값은 디코딩한 문자열로 해시
+	upbit = server.NewUpbit()
+	placed := upbit.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Price: "50000000", Volume: "0.001", Identifier: "bot:1+2 2022-06-01T09:00:00+09:00"})
+	if placed.Common.Error != nil {
+		t.Errorf("TestUpbitServerAuthorize | placeOrderErr:[%s]", placed.Common.Error)
+	}
+	y := upbit.Order(yauga.OrderOption{Identifier: "bot:1+2 2022-06-01T09:00:00+09:00"})
+	if y.Common.Error != nil || y.Response.Uuid != placed.Response.Uuid {
+		t.Errorf("TestUpbitServerAuthorize | Uuid:[%s], orderErr:[%s]", y.Response.Uuid, y.Common.Error)
+	}
+	token, _ := upbit.Payload(yauga.PayloadOption{WithParams: true, Params: "identifier=bot%3A1%2B2"})
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/order?identifier=bot%3A1%2B2", nil)
+	req.Header.Add("Authorization", token)
+	if res, err := server.Client().Do(req); err != nil || res.StatusCode != 401 {
+		t.Errorf("TestUpbitServerAuthorize | Escaped query_hash, requestErr:[%v]", err)
+	}
+
+	token, _ = upbit.Payload(yauga.PayloadOption{WithParams: true, Params: "market=KRW-ETH"})
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/v1/orders/chance?market=KRW-BTC", nil)
+	req.Header.Add("Authorization", token)
+	res, err := server.Client().Do(req)
+	if err != nil || res.StatusCode != 401 {
+		t.Errorf("TestUpbitServerAuthorize | Status:[%d], requestErr:[%v]", res.StatusCode, err)
+	}
+}
+
+// query_hash 고정값 테스트
+//  업비트와 같이 받은 순서대로 unquote(urlencode(params)) 한 문자열의 SHA512 여야 함
+func TestUpbitServerQueryHash(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	// unquote(urlencode([("market", "KRW-BTC"), ..., ("identifier", "bot:1+2 x")])) 의 SHA512
+	//  market=KRW-BTC&side=bid&volume=0.01&price=50000000&ord_type=limit&identifier=bot:1+2+x
+	const queryHash = "bf6d5bd3984e330f4f249ad9cfff55bc1bd0b66e78fa395e11af48467faf6c6cd9703ce38eec1aa5330f0aec892ed30cb3d87fb18220eedf66f1eef397f8daf1"
+	post := func(nonce string, body string) int {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"access_key": ACCESS_KEY, "nonce": nonce, "query_hash": queryHash, "query_hash_alg": "SHA512"}).SignedString([]byte(SECRET_KEY))
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/orders", strings.NewReader(body))
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("Content-Type", "application/json; charset=utf-8")
+		res, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatalf("TestUpbitServerQueryHash | requestErr:[%s]", err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	if status := post("nonce-1", `{"market":"KRW-BTC","side":"bid","volume":"0.01","price":"50000000","ord_type":"limit","identifier":"bot:1+2 x"}`); status != 201 {
+		t.Errorf("TestUpbitServerQueryHash | Status:[%d]", status)
+	}
+	// 같은 파라미터라도 보낸 순서가 다르면 다른 해시
+	if status := post("nonce-2", `{"identifier":"bot:1+2 x","market":"KRW-BTC","ord_type":"limit","price":"50000000","side":"bid","volume":"0.01"}`); status != 401 {
+		t.Errorf("TestUpbitServerQueryHash | Sorted Status:[%d]", status)
+	}
+}
+
+// 오류 주입 테스트
+func TestUpbitServerFailNext(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetMarkets([]yauga.UpbitMarketAllBlock{{Market: "KRW-BTC", KoreanName: "비트코인", EnglishName: "Bitcoin"}})
+	server.FailNext("/v1/market/all", 1, http.StatusTooManyRequests, "too_many_requests", "Too many requests")
+
+	upbit := server.NewUpbit(yauga.WithRetryPolicy(yauga.RetryPolicy{MaxAttempts: 1}))
+	x := upbit.MarketAll(false)
+	if x.Common.StatusCode != 429 || !errors.Is(x.Common.Error, yauga.ErrTooManyRequests) {
+		t.Errorf("TestUpbitServerFailNext | Status:[%d], marketAllErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	x = upbit.MarketAll(false)
+	if x.Common.StatusCode != 200 || x.Common.Error != nil {
+		t.Errorf("TestUpbitServerFailNext | Status:[%d], marketAllErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+}
+
+// 캔들 응답 형식 테스트
+//  업비트와 같이 시간대 없는 시각을 보내고, 클라이언트는 UTC, KST 로 해석해야 함
+func TestUpbitServerCandles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	utc := time.Date(2022, 5, 30, 0, 0, 0, 0, time.UTC)
+	server.SetCandles("KRW-BTC", yauga.INTERVAL_WEEK, []yauga.Candle{{Market: "KRW-BTC", CandleDateTimeUtc: utc, FirstDayOfPeriod: time.Date(2022, 5, 30, 0, 0, 0, 0, yauga.KST), TradePrice: 50000000}})
+
+	res, err := server.Client().Get(server.URL + "/v1/candles/weeks?market=KRW-BTC")
+	if err != nil {
+		t.Fatalf("TestUpbitServerCandles | requestErr:[%s]", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	for _, field := range []string{`"candle_date_time_utc":"2022-05-30T00:00:00"`, `"candle_date_time_kst":"2022-05-30T09:00:00"`, `"first_day_of_period":"2022-05-30"`} {
+		if !strings.Contains(string(body), field) {
+			t.Errorf("TestUpbitServerCandles | Body:[%s], Expected:[%s]", body, field)
+		}
+	}
+
+	x := server.NewUpbit().CandlesWeeks("KRW-BTC", time.Time{}, 1, "")
+	if x.Common.Error != nil || len(x.Response) != 1 || !x.Response[0].CandleDateTimeUtc.Equal(utc) || x.Response[0].CandleDateTimeKst.Location() != yauga.KST || x.Response[0].FirstDayOfPeriod.Location() != yauga.KST {
+		t.Errorf("TestUpbitServerCandles | Response:[%v], candlesErr:[%s]", x.Response, x.Common.Error)
+	}
+}
+
+// 주문 테스트
+//  POST 본문으로 계산한 query_hash 가 통과하고 주문이 상태에 남아야 함
+func TestUpbitServerPlaceOrder(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	upbit := server.NewUpbit()
+	x := upbit.PlaceOrder(yauga.PlaceOrderOption{Market: "KRW-BTC", Side: yauga.ORDER_SIDE_BID, OrdType: yauga.ORDER_TYPE_LIMIT, Volume: "0.01", Price: "50000000", Identifier: "test-1"})
+	if x.Common.StatusCode != 201 || x.Common.Error != nil || len(server.Orders()) != 1 {
+		t.Errorf("TestUpbitServerPlaceOrder | Status:[%d], placeOrderErr:[%s]", x.Common.StatusCode, x.Common.Error)
+	}
+	y := upbit.Order(yauga.OrderOption{Identifier: "test-1"})
+	if y.Common.StatusCode != 200 || y.Common.Error != nil || y.Response.Uuid != x.Response.Uuid {
+		t.Errorf("TestUpbitServerPlaceOrder | Status:[%d], orderErr:[%s]", y.Common.StatusCode, y.Common.Error)
+	}
+}
@@ -0,0 +1,247 @@
+package yauga
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// 주문 가능 정보 기본 캐시 시간
+	ORDERS_CHANCE_CACHE_TTL = 5 * time.Second
+)
+
+// 주문 위반 사항
+//  Name 은 업비트가 같은 주문을 거절할 때 보낼 오류 이름이라 errors.Is(v, ErrInsufficientFundsBid) 처럼 확인할 수 있습니다.
+type OrderViolation struct {
+	// 위반한 필드 (ex. OrdType, Side, Market, Price, Volume, Total, Balance)
+	Field string
+	// 업비트 오류 이름 (ex. insufficient_funds_bid, under_min_total_ask, invalid_price_bid, validation_error)
+	Name string
+	// 위반 내용
+	Message string
+}
+
+func (v OrderViolation) Error() string {
+	return v.Field + ": " + v.Message
+}
+
+// 같은 이름의 *APIError 와 일치
+func (v OrderViolation) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Name != "" && t.Name == v.Name
+}
+
+// 주문 사전 검증 결과
+//  주문 가능 정보 조회에 실패하면 Common.Error 에 담기고 Response 는 비어 있습니다.
+type UpbitOrderValidation struct {
+	Response []OrderViolation
+	Common   UpbitCommonBlock
+}
+
+// 위반 사항이 없는지 확인
+func (v UpbitOrderValidation) OK() bool {
+	return v.Common.Error == nil && len(v.Response) <= 0
+}
+
+// 주문 가능 정보 캐시 항목
+type chanceEntry struct {
+	chance  UpbitOrdersChance
+	expires time.Time
+}
+
+// 주문 사전 검증기
+//  주문 가능 정보(orders/chance)를 마켓별로 캐시해 두고 주문을 보내기 전에
+//	주문 방식, 주문 종류, 마켓 상태, 호가 단위, 최소/최대 주문 금액, 수수료를 포함한 잔고를 확인합니다.
+type OrderValidator struct {
+	exchange     Exchange
+	ttl          time.Duration
+	tickSizeFunc func(market string, price Decimal) Decimal
+
+	mu    sync.Mutex
+	cache map[string]chanceEntry
+}
+
+// Initialization
+// Params:
+//	exchange = 주문 가능 정보를 조회할 구현 (ex. *Upbit, paper.NewExchange(...))
+//	ttl = 주문 가능 정보 캐시 시간 (0 이하면 ORDERS_CHANCE_CACHE_TTL)
+func NewOrderValidator(exchange Exchange, ttl time.Duration) *OrderValidator {
+	if ttl <= 0 {
+		ttl = ORDERS_CHANCE_CACHE_TTL
+	}
+	return &OrderValidator{exchange: exchange, ttl: ttl, cache: map[string]chanceEntry{}}
+}
+
+// 호가 단위 계산 세팅
+//  업비트 호가 정책이 내장된 표와 다를 때 덮어씁니다. nil 이면 TickSizeDecimal 을 사용합니다.
+func (v *OrderValidator) SetTickSizeFunc(fn func(market string, price Decimal) Decimal) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.tickSizeFunc = fn
+}
+
+// 캐시한 주문 가능 정보 지우기
+//  주문, 체결로 잔고가 바뀌었을 때 호출하세요. market 을 비우면 전부 지웁니다.
+func (v *OrderValidator) Invalidate(market string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if market == "" {
+		v.cache = map[string]chanceEntry{}
+		return
+	}
+	delete(v.cache, market)
+}
+
+// 마켓의 주문 가능 정보 (캐시)
+//  성공한 결과만 ttl 동안 재사용합니다.
+func (v *OrderValidator) ChanceContext(ctx context.Context, market string) UpbitOrdersChance {
+	v.mu.Lock()
+	entry, ok := v.cache[market]
+	v.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.chance
+	}
+
+	parts := strings.SplitN(market, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		var res UpbitOrdersChance
+		res.Common.Error = newValidationError("Market", "Market must be like KRW-BTC!")
+		return res
+	}
+	res := v.exchange.OrdersChanceContext(ctx, parts[0], parts[1])
+	if res.Common.Error == nil {
+		v.mu.Lock()
+		v.cache[market] = chanceEntry{chance: res, expires: time.Now().Add(v.ttl)}
+		v.mu.Unlock()
+	}
+	return res
+}
+
+// 주문 사전 검증
+//  업비트에 보내지 않고 거절될 주문인지 확인해 위반 사항을 모두 반환합니다.
+// Params:
+//	opt = 주문 옵션
+func (v *OrderValidator) Validate(opt PlaceOrderOption) UpbitOrderValidation {
+	return v.ValidateContext(context.Background(), opt)
+}
+
+// Validate 의 context 버전
+func (v *OrderValidator) ValidateContext(ctx context.Context, opt PlaceOrderOption) UpbitOrderValidation {
+	var res UpbitOrderValidation
+	failed := map[string]bool{}
+	add := func(field string, message string) {
+		res.Response = append(res.Response, OrderViolation{Field: field, Name: ErrValidation.Name, Message: message})
+		failed[field] = true
+	}
+	for _, validationErr := range opt.validationErrors() {
+		add(validationErr.Field, validationErr.Message)
+	}
+	var price, volume Decimal
+	var err error
+	if opt.Price != "" && !failed["Price"] {
+		if price, err = ParseDecimal(opt.Price); err != nil || price.Sign() <= 0 {
+			add("Price", "Price must be a positive number!")
+		}
+	}
+	if opt.Volume != "" && !failed["Volume"] {
+		if volume, err = ParseDecimal(opt.Volume); err != nil || volume.Sign() <= 0 {
+			add("Volume", "Volume must be a positive number!")
+		}
+	}
+	if failed["Market"] {
+		return res
+	}
+
+	// 잘못된 필드와 상관없는 확인은 계속합니다.
+	chance := v.ChanceContext(ctx, opt.Market)
+	res.Common = chance.Common
+	if chance.Common.Error != nil {
+		return res
+	}
+	n := NewNormalizer(chance.Response)
+	v.mu.Lock()
+	n.TickSizeFunc = v.tickSizeFunc
+	v.mu.Unlock()
+	res.Response = append(res.Response, checkOrder(n, opt, price, volume, failed)...)
+	if res.Response == nil {
+		res.Response = []OrderViolation{}
+	}
+	return res
+}
+
+// 주문 가능 정보로 주문 확인
+//  failed 에 있는 필드에 기대는 확인은 건너뜁니다.
+func checkOrder(n *Normalizer, opt PlaceOrderOption, price Decimal, volume Decimal, failed map[string]bool) []OrderViolation {
+	violations := []OrderViolation{}
+	add := func(field string, name string, format string, args ...interface{}) {
+		violations = append(violations, OrderViolation{Field: field, Name: name, Message: fmt.Sprintf(format, args...)})
+	}
+	market := n.Chance.Market
+	side := string(opt.Side)
+	hasPrice := opt.Price != "" && !failed["Price"]
+	hasVolume := opt.Volume != "" && !failed["Volume"]
+
+	if market.State != "active" {
+		add("Market", ErrValidation.Name, "Market state is %s!", market.State)
+	}
+	if !failed["OrdType"] && !containsString(market.OrderTypes, string(opt.OrdType)) {
+		add("OrdType", ErrValidation.Name, "OrdType `%s` is not supported (%s)!", opt.OrdType, strings.Join(market.OrderTypes, ", "))
+	}
+	if !failed["Side"] && !containsString(market.OrderSides, side) {
+		add("Side", ErrValidation.Name, "Side `%s` is not supported (%s)!", opt.Side, strings.Join(market.OrderSides, ", "))
+	}
+
+	// 주문 금액 (시장가 매도, 최유리 매도는 가격을 모름)
+	var total Decimal
+	hasTotal := false
+	switch {
+	case opt.OrdType == ORDER_TYPE_LIMIT:
+		if hasPrice && !n.RoundPrice(price, ROUND_DOWN).Equal(price) {
+			add("Price", "invalid_price_"+side, "Price %s is not a multiple of tick size %s!", price, n.TickSize(price))
+		}
+		total, hasTotal = price.Mul(volume), hasPrice && hasVolume
+	case hasPrice:
+		total, hasTotal = price, true
+	}
+	if hasTotal {
+		if min := n.MinTotal(opt.Side); min.Sign() > 0 && total.LessThan(min) {
+			add("Total", "under_min_total_"+side, "Total %s is under the minimum %s!", total, min)
+		}
+		if max := n.MaxTotal(); max.Sign() > 0 && total.GreaterThan(max) {
+			add("Total", ErrValidation.Name, "Total %s is over the maximum %s!", total, max)
+		}
+	}
+
+	// 수수료를 포함한 잔고
+	switch {
+	case opt.Side == ORDER_SIDE_BID && hasTotal:
+		need := total.Add(n.Fee(ORDER_SIDE_BID, total))
+		if balance := n.Chance.BidAccount.Balance; need.GreaterThan(balance) {
+			add("Balance", ErrInsufficientFundsBid.Name, "Need %s %s with fee but only %s is available!", need, n.Chance.BidAccount.Currency, balance)
+		}
+	case opt.Side == ORDER_SIDE_ASK && hasVolume:
+		if balance := n.Chance.AskAccount.Balance; volume.GreaterThan(balance) {
+			add("Balance", ErrInsufficientFundsAsk.Name, "Need %s %s but only %s is available!", volume, n.Chance.AskAccount.Currency, balance)
+		}
+	}
+	return violations
+}
+
+// 문자열 목록에 포함되어 있는지 확인
+func containsString(list []string, target string) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package yauga_test
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/davidjung-kr/yauga"
+)
+
+// OrderValidator 테스트
+//  주문 가능 정보로 위반 사항을 모두 찾고, 주문 가능 정보는 캐시해야 함
+func TestUpbitOrderValidator(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	server.SetChance(testChance())
+	validator := NewOrderValidator(server.NewUpbit(), time.Minute)
+
+	x := validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001000", Volume: "0.001"})
+	if !x.OK() {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v], validateErr:[%s]", x.Response, x.Common.Error)
+	}
+
+	// 호가 단위 덮어쓰기
+	validator.SetTickSizeFunc(func(market string, price Decimal) Decimal { return MustDecimal("10000") })
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001000", Volume: "0.001"})
+	if len(x.Response) != 1 || !errors.Is(x.Response[0], ErrInvalidPriceBid) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+	validator.SetTickSizeFunc(nil)
+
+	// 호가 단위, 최소 주문 금액 위반
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001234", Volume: "0.00001"})
+	if len(x.Response) != 2 || !errors.Is(x.Response[0], ErrInvalidPriceBid) || !errors.Is(x.Response[1], ErrUnderMinTotalBid) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+
+	// 수수료를 포함한 잔고 부족
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_PRICE, Price: "999600"})
+	if len(x.Response) != 1 || !errors.Is(x.Response[0], ErrInsufficientFundsBid) || x.Response[0].Field != "Balance" {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_ASK, OrdType: ORDER_TYPE_MARKET, Volume: "0.1"})
+	if len(x.Response) != 1 || !errors.Is(x.Response[0], ErrInsufficientFundsAsk) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+
+	// 지원하지 않는 주문 방식
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_BEST, Price: "10000", TimeInForce: TIME_IN_FORCE_IOC})
+	if len(x.Response) != 1 || x.Response[0].Field != "OrdType" || !errors.Is(x.Response[0], ErrValidation) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+
+	// 주문 옵션 자체가 잘못돼도 위반 사항을 모두 찾고 상관없는 확인은 계속함
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001234", TimeInForce: "gtc"})
+	if len(x.Response) != 3 || x.Response[0].Field != "Volume" || x.Response[1].Field != "TimeInForce" || !errors.Is(x.Response[2], ErrInvalidPriceBid) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_ASK, OrdType: ORDER_TYPE_MARKET, Price: "abc", Volume: "0.1"})
+	if len(x.Response) != 2 || x.Response[0].Field != "Price" || !errors.Is(x.Response[1], ErrInsufficientFundsAsk) {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+
+	// 캐시 확인 후 거래 중지된 마켓
+	chance := testChance()
+	chance.Market.State = "inactive"
+	server.SetChance(chance)
+	if x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001000", Volume: "0.001"}); !x.OK() {
+		t.Errorf("TestUpbitOrderValidator | Cached Violations:[%v]", x.Response)
+	}
+	validator.Invalidate("KRW-BTC")
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "50001000", Volume: "0.001"})
+	if len(x.Response) != 1 || x.Response[0].Field != "Market" {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-BTC", OrdType: ORDER_TYPE_LIMIT, Price: "50001000", Volume: "0.001"})
+	if len(x.Response) != 2 || x.Response[0].Field != "Side" || x.Response[1].Field != "Market" {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v]", x.Response)
+	}
+
+	requests := 0
+	for _, v := range server.Requests() {
+		if strings.HasPrefix(v, "GET /v1/orders/chance") {
+			requests++
+		}
+	}
+	if requests != 2 {
+		t.Errorf("TestUpbitOrderValidator | Requests:[%d]", requests)
+	}
+
+	// 없는 마켓은 업비트 오류
+	x = validator.Validate(PlaceOrderOption{Market: "KRW-XRP", Side: ORDER_SIDE_BID, OrdType: ORDER_TYPE_LIMIT, Price: "500", Volume: "100"})
+	if x.OK() || x.Common.Error == nil || len(x.Response) != 0 {
+		t.Errorf("TestUpbitOrderValidator | Violations:[%v], validateErr:[%s]", x.Response, x.Common.Error)
+	}
+}
@@ -0,0 +1,726 @@
+package websocket
+
+/**
+ * yauga -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+	"github.com/google/uuid"
+	gws "github.com/gorilla/websocket"
+)
+
+const (
+	// [WebSocket] 시세 수신 (Quotation stream)
+	UPBIT_URL_WEBSOCKET = yauga.UPBIT_WEBSOCKET_HOST + "/websocket/v1"
+	// [WebSocket] 내 주문 및 체결, 내 자산 수신 (Private stream)
+	UPBIT_URL_WEBSOCKET_PRIVATE = yauga.UPBIT_WEBSOCKET_HOST + "/websocket/v1/private"
+	// 웹소켓 수신 채널 버퍼 크기
+	WEBSOCKET_CHANNEL_SIZE = 256
+	// ping 전송 주기 (업비트는 120초간 메시지가 없으면 연결을 끊음)
+	WEBSOCKET_PING_INTERVAL = 30 * time.Second
+	// pong 대기 시간. 이 시간 동안 수신이 없으면 끊긴 것으로 봅니다.
+	WEBSOCKET_PONG_WAIT = 75 * time.Second
+	// 재연결 최소 대기 시간
+	WEBSOCKET_RECONNECT_MIN_WAIT = time.Second
+	// 재연결 최대 대기 시간
+	WEBSOCKET_RECONNECT_MAX_WAIT = time.Minute
+	// 중복 확인을 위해 마켓별로 기억하는 최근 체결 번호 수
+	WEBSOCKET_SEQUENCE_WINDOW = 1024
+)
+
+// 웹소켓 연결 상태 이벤트 종류
+type WsEventType string
+
+const (
+	// 연결됨
+	WS_EVENT_CONNECTED WsEventType = "connected"
+	// 연결 끊김. 재연결될 때까지 수신이 중단됩니다.
+	WS_EVENT_DISCONNECTED WsEventType = "disconnected"
+	// 재연결 시도 실패
+	WS_EVENT_RECONNECT_FAILED WsEventType = "reconnect_failed"
+	// 재연결 및 재구독 완료
+	WS_EVENT_RECONNECTED WsEventType = "reconnected"
+	// 연결이 끊긴 사이 체결이 누락되었을 수 있음 (누락 가능성 알림)
+	//  체결 번호는 연속이 아니라 실제 누락 여부는 알 수 없습니다.
+	//	재연결할 때마다 마켓별로 처음 받은 새 체결에서 한 번 보내므로, 필요하면 그 사이 체결을 TradesTicks 로 확인하세요.
+	WS_EVENT_GAP WsEventType = "gap"
+)
+
+// 웹소켓 연결 상태 이벤트
+type UpbitWsEvent struct {
+	// 이벤트 종류
+	Type WsEventType
+	// 원인 오류 (disconnected, reconnect_failed)
+	Error error
+	// 재연결 시도 횟수 (reconnected, reconnect_failed)
+	Attempt int
+	// 마켓 코드 (gap)
+	Market string
+	// 끊기기 전 가장 큰 체결 번호 (gap)
+	LastSequentialId int64
+	// 재연결 후 처음 받은 체결 번호 (gap)
+	SequentialId int64
+}
+
+// 웹소켓 구독 타입
+type StreamType string
+
+const (
+	// 현재가
+	STREAM_TYPE_TICKER StreamType = "ticker"
+	// 체결
+	STREAM_TYPE_TRADE StreamType = "trade"
+	// 호가
+	STREAM_TYPE_ORDERBOOK StreamType = "orderbook"
+	// 내 주문 및 체결 (인증 필요)
+	STREAM_TYPE_MY_ORDER StreamType = "myOrder"
+	// 내 자산 (인증 필요)
+	STREAM_TYPE_MY_ASSET StreamType = "myAsset"
+)
+
+// 웹소켓 시세 수신 클라이언트
+//  Subscribe 로 구독할 마켓을 정한 뒤 Connect 하면 구독 타입별 채널로 수신합니다.
+//	수신 중 오류는 Error 채널로, 연결 끊김/재연결/체결 누락은 Event 채널로 전달되며,
+//	Close 하면 모든 채널이 닫힙니다.
+type UpbitWebSocket struct {
+	// 현재가 수신 채널
+	Ticker chan UpbitWsTickerBlock
+	// 체결 수신 채널
+	Trade chan UpbitWsTradeBlock
+	// 호가 수신 채널
+	Orderbook chan UpbitWsOrderbookBlock
+	// 내 주문 및 체결 수신 채널
+	MyOrder chan UpbitWsMyOrderBlock
+	// 내 자산 수신 채널
+	MyAsset chan UpbitWsMyAssetBlock
+	// 수신 오류 채널
+	Error chan error
+	// 연결 상태 이벤트 채널
+	Event chan UpbitWsEvent
+
+	url           string
+	auth          *yauga.Upbit
+	conn          *gws.Conn
+	subscriptions map[StreamType][]string
+	done          chan struct{}
+	mu            sync.Mutex
+	wg            sync.WaitGroup
+	closed        bool
+
+	// 마켓별 가장 큰 체결 번호
+	lastSequentialId map[string]int64
+	// 마켓별 최근 체결 번호 (중복 확인용)
+	recentSequentialIds map[string]*sequenceWindow
+	// 연결이 끊겼던 마켓
+	interrupted map[string]bool
+}
+
+// Initialization
+func NewUpbitWebSocket() *UpbitWebSocket {
+	return &UpbitWebSocket{
+		Ticker:        make(chan UpbitWsTickerBlock, WEBSOCKET_CHANNEL_SIZE),
+		Trade:         make(chan UpbitWsTradeBlock, WEBSOCKET_CHANNEL_SIZE),
+		Orderbook:     make(chan UpbitWsOrderbookBlock, WEBSOCKET_CHANNEL_SIZE),
+		MyOrder:       make(chan UpbitWsMyOrderBlock, WEBSOCKET_CHANNEL_SIZE),
+		MyAsset:       make(chan UpbitWsMyAssetBlock, WEBSOCKET_CHANNEL_SIZE),
+		Error:         make(chan error, WEBSOCKET_CHANNEL_SIZE),
+		Event:         make(chan UpbitWsEvent, WEBSOCKET_CHANNEL_SIZE),
+		url:           UPBIT_URL_WEBSOCKET,
+		subscriptions: map[StreamType][]string{},
+		done:          make(chan struct{}),
+
+		lastSequentialId:    map[string]int64{},
+		recentSequentialIds: map[string]*sequenceWindow{},
+		interrupted:         map[string]bool{},
+	}
+}
+
+// Initialization (API 호스트 지정)
+//  upbit 에 세팅한 API 호스트(Upbit.BaseURL) 기준으로 연결합니다.
+// Params:
+//	upbit = API 호스트를 가져올 Upbit
+func NewUpbitWebSocketFor(upbit *yauga.Upbit) *UpbitWebSocket {
+	ws := NewUpbitWebSocket()
+	ws.url = upbit.ResolveURL(UPBIT_URL_WEBSOCKET)
+	return ws
+}
+
+// Initialization (Private stream)
+//  내 주문 및 체결(myOrder), 내 자산(myAsset)을 수신합니다.
+//	upbit 에 세팅한 API 호스트 기준으로 연결하며,
+//	연결할 때마다 upbit 의 access key, secret key 로 JWT 토큰을 새로 만들어 인증합니다.
+// Params:
+//	upbit = 인증에 사용할 Upbit (SetSecretKey 필요)
+func NewUpbitPrivateWebSocket(upbit *yauga.Upbit) *UpbitWebSocket {
+	ws := NewUpbitWebSocket()
+	ws.url = upbit.ResolveURL(UPBIT_URL_WEBSOCKET_PRIVATE)
+	ws.auth = upbit
+	return ws
+}
+
+// 구독 추가
+//  이미 연결되어 있으면 전체 구독 목록을 다시 요청합니다.
+//	myOrder 는 markets 를 비우면 전체 마켓, myAsset 은 markets 를 쓰지 않습니다.
+// Params:
+//	streamType = 구독 타입
+//	markets = 마켓 코드 목록 (ex. KRW-BTC)
+func (o *UpbitWebSocket) Subscribe(streamType StreamType, markets []string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.subscriptions[streamType]; !ok {
+		o.subscriptions[streamType] = []string{}
+	}
+	for _, market := range markets {
+		if !containsString(o.subscriptions[streamType], market) {
+			o.subscriptions[streamType] = append(o.subscriptions[streamType], market)
+		}
+	}
+	if o.conn == nil {
+		return nil
+	}
+	return o.sendSubscriptions()
+}
+
+// 연결
+//  구독 목록을 요청하고 수신을 시작합니다.
+//	이후 연결이 끊기면 지수 백오프로 재연결하고 구독 목록을 다시 요청합니다.
+func (o *UpbitWebSocket) Connect() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return errors.New("WebSocket was closed!")
+	}
+	if o.conn != nil {
+		return errors.New("WebSocket is already connected!")
+	}
+	if err := o.dial(); err != nil {
+		return err
+	}
+	o.sendEvent(UpbitWsEvent{Type: WS_EVENT_CONNECTED})
+	o.wg.Add(1)
+	go o.run(o.conn)
+	return nil
+}
+
+// 연결 종료
+//  수신을 멈추고 모든 채널을 닫습니다.
+func (o *UpbitWebSocket) Close() error {
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return nil
+	}
+	o.closed = true
+	close(o.done)
+	var err error
+	if o.conn != nil {
+		o.conn.WriteControl(gws.CloseMessage, gws.FormatCloseMessage(gws.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		err = o.conn.Close()
+	}
+	o.mu.Unlock()
+
+	o.wg.Wait()
+	close(o.Ticker)
+	close(o.Trade)
+	close(o.Orderbook)
+	close(o.MyOrder)
+	close(o.MyAsset)
+	close(o.Error)
+	close(o.Event)
+	return err
+}
+
+// 웹소켓 연결 후 구독 요청
+//  호출 전에 mu 를 잡고 있어야 합니다.
+func (o *UpbitWebSocket) dial() error {
+	header := http.Header{}
+	if o.auth != nil {
+		token, err := o.auth.Payload(yauga.PayloadOption{WithParams: false})
+		if err != nil {
+			return err
+		}
+		header.Add("Authorization", token)
+	}
+	conn, res, err := gws.DefaultDialer.Dial(o.url, header)
+	if err != nil {
+		if res != nil && res.StatusCode != http.StatusSwitchingProtocols {
+			body, _ := ioutil.ReadAll(res.Body)
+			res.Body.Close()
+			return yauga.ParseAPIError(res.StatusCode, body)
+		}
+		return err
+	}
+	conn.SetReadDeadline(time.Now().Add(WEBSOCKET_PONG_WAIT))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(WEBSOCKET_PONG_WAIT))
+	})
+	o.conn = conn
+	if err := o.sendSubscriptions(); err != nil {
+		conn.Close()
+		o.conn = nil
+		return err
+	}
+	return nil
+}
+
+// 구독 요청 보내기
+//  [{ticket}, {type, codes}..., {format}] 형태로 보냅니다. 호출 전에 mu 를 잡고 있어야 합니다.
+func (o *UpbitWebSocket) sendSubscriptions() error {
+	request := []interface{}{map[string]string{"ticket": uuid.New().String()}}
+	for _, streamType := range []StreamType{STREAM_TYPE_TICKER, STREAM_TYPE_TRADE, STREAM_TYPE_ORDERBOOK, STREAM_TYPE_MY_ORDER, STREAM_TYPE_MY_ASSET} {
+		markets, ok := o.subscriptions[streamType]
+		if !ok {
+			continue
+		}
+		subscription := map[string]interface{}{"type": streamType}
+		if len(markets) > 0 {
+			subscription["codes"] = markets
+		}
+		request = append(request, subscription)
+	}
+	request = append(request, map[string]string{"format": "DEFAULT"})
+	return o.conn.WriteJSON(request)
+}
+
+// 수신 및 재연결 루프
+func (o *UpbitWebSocket) run(conn *gws.Conn) {
+	defer o.wg.Done()
+	for {
+		err := o.read(conn)
+		if o.isClosed() {
+			return
+		}
+		o.sendError(err)
+		o.sendEvent(UpbitWsEvent{Type: WS_EVENT_DISCONNECTED, Error: err})
+		o.markInterrupted()
+
+		conn = o.reconnect()
+		if conn == nil {
+			return
+		}
+	}
+}
+
+// 연결이 끊길 때까지 수신
+//  WEBSOCKET_PING_INTERVAL 마다 ping 을 보내고, pong 이 WEBSOCKET_PONG_WAIT 동안 없으면 끊습니다.
+func (o *UpbitWebSocket) read(conn *gws.Conn) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		ticker := time.NewTicker(WEBSOCKET_PING_INTERVAL)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := conn.WriteControl(gws.PingMessage, nil, time.Now().Add(WEBSOCKET_PING_INTERVAL)); err != nil {
+					conn.Close()
+					return
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		if err := o.dispatch(message); err != nil {
+			o.sendError(err)
+		}
+	}
+}
+
+// 재연결
+//  WEBSOCKET_RECONNECT_MIN_WAIT 부터 두 배씩 늘려가며 WEBSOCKET_RECONNECT_MAX_WAIT 까지 기다립니다.
+//	Close 되면 nil 을 반환합니다.
+func (o *UpbitWebSocket) reconnect() *gws.Conn {
+	wait := WEBSOCKET_RECONNECT_MIN_WAIT
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-time.After(wait):
+		case <-o.done:
+			return nil
+		}
+
+		o.mu.Lock()
+		if o.closed {
+			o.mu.Unlock()
+			return nil
+		}
+		o.conn = nil
+		err := o.dial()
+		conn := o.conn
+		o.mu.Unlock()
+		if err == nil {
+			o.sendEvent(UpbitWsEvent{Type: WS_EVENT_RECONNECTED, Attempt: attempt})
+			return conn
+		}
+
+		o.sendEvent(UpbitWsEvent{Type: WS_EVENT_RECONNECT_FAILED, Error: err, Attempt: attempt})
+		wait *= 2
+		if wait > WEBSOCKET_RECONNECT_MAX_WAIT {
+			wait = WEBSOCKET_RECONNECT_MAX_WAIT
+		}
+	}
+}
+
+// Close 여부
+func (o *UpbitWebSocket) isClosed() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.closed
+}
+
+// 연결이 끊겼던 마켓 표시
+//  재연결 후 처음 받은 체결로 누락 구간을 알립니다.
+func (o *UpbitWebSocket) markInterrupted() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for market := range o.lastSequentialId {
+		o.interrupted[market] = true
+	}
+}
+
+// 최근 체결 번호 모음
+//  WEBSOCKET_SEQUENCE_WINDOW 개까지 기억하고, 넘치면 가장 먼저 받은 번호부터 잊습니다.
+type sequenceWindow struct {
+	seen map[int64]bool
+	ids  []int64
+	next int
+}
+
+// 체결 번호 추가 (이미 받은 번호면 false)
+func (w *sequenceWindow) add(id int64) bool {
+	if w.seen[id] {
+		return false
+	}
+	w.seen[id] = true
+	if len(w.ids) < WEBSOCKET_SEQUENCE_WINDOW {
+		w.ids = append(w.ids, id)
+		return true
+	}
+	delete(w.seen, w.ids[w.next])
+	w.ids[w.next] = id
+	w.next = (w.next + 1) % WEBSOCKET_SEQUENCE_WINDOW
+	return true
+}
+
+// 체결 번호로 중복과 누락 확인
+//  최근에 이미 받은 체결 번호(재연결 후 다시 받은 체결)면 false 를 반환합니다.
+//	체결 번호는 순서를 보장하지 않으므로 늦게 도착한 작은 번호의 체결도 처음 받은 것이면 전달합니다.
+//	연결이 끊겼던 마켓이면 처음 받은 새 체결로 WS_EVENT_GAP 이벤트를 보냅니다.
+func (o *UpbitWebSocket) checkSequence(block UpbitWsTradeBlock) bool {
+	o.mu.Lock()
+	window, ok := o.recentSequentialIds[block.Code]
+	if !ok {
+		window = &sequenceWindow{seen: map[int64]bool{}}
+		o.recentSequentialIds[block.Code] = window
+	}
+	if !window.add(block.SequentialId) {
+		o.mu.Unlock()
+		return false
+	}
+	last := o.lastSequentialId[block.Code]
+	if block.SequentialId > last {
+		o.lastSequentialId[block.Code] = block.SequentialId
+	}
+	interrupted := o.interrupted[block.Code]
+	delete(o.interrupted, block.Code)
+	o.mu.Unlock()
+
+	if interrupted {
+		o.sendEvent(UpbitWsEvent{
+			Type:             WS_EVENT_GAP,
+			Market:           block.Code,
+			LastSequentialId: last,
+			SequentialId:     block.SequentialId,
+		})
+	}
+	return true
+}
+
+// 수신한 메시지를 타입별 채널로 보내기
+func (o *UpbitWebSocket) dispatch(message []byte) error {
+	var head struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(message, &head); err != nil {
+		return err
+	}
+	switch StreamType(head.Type) {
+	case STREAM_TYPE_TICKER:
+		var block UpbitWsTickerBlock
+		if err := json.Unmarshal(message, &block); err != nil {
+			return err
+		}
+		block.Market = block.Code
+		select {
+		case o.Ticker <- block:
+		case <-o.done:
+		}
+	case STREAM_TYPE_TRADE:
+		var block UpbitWsTradeBlock
+		if err := json.Unmarshal(message, &block); err != nil {
+			return err
+		}
+		block.Market = block.Code
+		if !o.checkSequence(block) {
+			return nil
+		}
+		select {
+		case o.Trade <- block:
+		case <-o.done:
+		}
+	case STREAM_TYPE_ORDERBOOK:
+		var block UpbitWsOrderbookBlock
+		if err := json.Unmarshal(message, &block); err != nil {
+			return err
+		}
+		block.Market = block.Code
+		select {
+		case o.Orderbook <- block:
+		case <-o.done:
+		}
+	case STREAM_TYPE_MY_ORDER:
+		var block UpbitWsMyOrderBlock
+		if err := json.Unmarshal(message, &block); err != nil {
+			return err
+		}
+		select {
+		case o.MyOrder <- block:
+		case <-o.done:
+		}
+	case STREAM_TYPE_MY_ASSET:
+		var block UpbitWsMyAssetBlock
+		if err := json.Unmarshal(message, &block); err != nil {
+			return err
+		}
+		select {
+		case o.MyAsset <- block:
+		case <-o.done:
+		}
+	}
+	return nil
+}
+
+// 오류 채널로 보내기
+//  채널이 가득 차 있으면 버립니다.
+func (o *UpbitWebSocket) sendError(err error) {
+	select {
+	case o.Error <- err:
+	default:
+	}
+}
+
+// 문자열 목록에 포함되어 있는지 확인
+func containsString(list []string, target string) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// 이벤트 채널로 보내기
+//  채널이 가득 차 있으면 버립니다.
+func (o *UpbitWebSocket) sendEvent(event UpbitWsEvent) {
+	select {
+	case o.Event <- event:
+	default:
+	}
+}
+
+// 현재가 @ ticker 수신 Block
+//  REST 현재가 Block 에 웹소켓 전용 필드를 더했습니다. Market 에는 Code 가 채워집니다.
+type UpbitWsTickerBlock struct {
+	// 타입 [String]
+	Type string `json:"type"`
+	// 마켓 코드 (ex. KRW-BTC) [String]
+	Code string `json:"code"`
+	yauga.UpbitTickerBlock
+	// 매수/매도 구분 [String]
+	AskBid string `json:"ask_bid"`
+	// 누적 매도량 [Double]
+	AccAskVolume float64 `json:"acc_ask_volume"`
+	// 누적 매수량 [Double]
+	AccBidVolume float64 `json:"acc_bid_volume"`
+	// 거래상태 [String]
+	MarketState string `json:"market_state"`
+	// 거래 정지 여부 [Boolean]
+	IsTradingSuspended bool `json:"is_trading_suspended"`
+	// 상장폐지일 [Date]
+	DelistingDate string `json:"delisting_date"`
+	// 유의 종목 여부 [String]
+	MarketWarning string `json:"market_warning"`
+	// 스트림 타입 (SNAPSHOT, REALTIME) [String]
+	StreamType string `json:"stream_type"`
+}
+
+// 체결 @ trade 수신 Block
+//  REST 최근 체결 내역 Block 에 웹소켓 전용 필드를 더했습니다. Market 에는 Code 가 채워집니다.
+type UpbitWsTradeBlock struct {
+	// 타입 [String]
+	Type string `json:"type"`
+	// 마켓 코드 (ex. KRW-BTC) [String]
+	Code string `json:"code"`
+	yauga.UpbitTradesTicksBlock
+	// 체결 일자(UTC 기준) [String]
+	TradeDate string `json:"trade_date"`
+	// 체결 시각(UTC 기준) [String]
+	TradeTime string `json:"trade_time"`
+	// 체결 타임스탬프 (millisecond) [Long]
+	TradeTimestamp int64 `json:"trade_timestamp"`
+	// 전일 대비 - RISE(상승), EVEN(보합), FALL(하락) [String]
+	Change string `json:"change"`
+	// 스트림 타입 (SNAPSHOT, REALTIME) [String]
+	StreamType string `json:"stream_type"`
+}
+
+// 호가 @ orderbook 수신 Block
+//  REST 호가 정보 Block 에 웹소켓 전용 필드를 더했습니다. Market 에는 Code 가 채워집니다.
+type UpbitWsOrderbookBlock struct {
+	// 타입 [String]
+	Type string `json:"type"`
+	// 마켓 코드 (ex. KRW-BTC) [String]
+	Code string `json:"code"`
+	yauga.UpbitOrderbookBlock
+	// 스트림 타입 (SNAPSHOT, REALTIME) [String]
+	StreamType string `json:"stream_type"`
+}
+
+// 내 주문 및 체결 @ myOrder 수신 Block
+//  금액, 수량은 yauga.Decimal 로 받아 오차 없이 다룹니다.
+type UpbitWsMyOrderBlock struct {
+	// 타입 [String]
+	Type string `json:"type"`
+	// 마켓 코드 (ex. KRW-BTC) [String]
+	Code string `json:"code"`
+	// 주문 고유 아이디 [String]
+	Uuid string `json:"uuid"`
+	// 매수/매도 구분 (ASK, BID) [String]
+	AskBid string `json:"ask_bid"`
+	// 주문 타입 (limit, price, market, best) [String]
+	OrderType string `json:"order_type"`
+	// 주문 상태 (wait, watch, trade, done, cancel, prevented) [String]
+	State string `json:"state"`
+	// 체결의 고유 아이디 [String]
+	TradeUuid string `json:"trade_uuid"`
+	// 주문 가격, 체결 가격 (state: trade 일 때) [Number]
+	Price yauga.Decimal `json:"price"`
+	// 평균 체결 가격 [Number]
+	AvgPrice yauga.Decimal `json:"avg_price"`
+	// 주문량, 체결량 (state: trade 일 때) [Number]
+	Volume yauga.Decimal `json:"volume"`
+	// 체결 후 주문 잔량 [Number]
+	RemainingVolume yauga.Decimal `json:"remaining_volume"`
+	// 체결된 양 [Number]
+	ExecutedVolume yauga.Decimal `json:"executed_volume"`
+	// 해당 주문에 걸린 체결 수 [Integer]
+	TradesCount int `json:"trades_count"`
+	// 수수료로 예약된 비용 [Number]
+	ReservedFee yauga.Decimal `json:"reserved_fee"`
+	// 남은 수수료 [Number]
+	RemainingFee yauga.Decimal `json:"remaining_fee"`
+	// 사용된 수수료 [Number]
+	PaidFee yauga.Decimal `json:"paid_fee"`
+	// 거래에 사용중인 비용 [Number]
+	Locked yauga.Decimal `json:"locked"`
+	// 체결된 금액 [Number]
+	ExecutedFunds yauga.Decimal `json:"executed_funds"`
+	// IOC, FOK 설정 [String]
+	TimeInForce string `json:"time_in_force"`
+	// 체결 시 발생한 수수료 (state: trade 가 아니면 null) [Number]
+	TradeFee yauga.Decimal `json:"trade_fee"`
+	// 체결이 발생한 주문의 메이커/테이커 여부 [Boolean]
+	IsMaker bool `json:"is_maker"`
+	// 클라이언트 지정 주문 식별자 [String]
+	Identifier string `json:"identifier"`
+	// 체결 타임스탬프 (millisecond) [Long]
+	TradeTimestamp int64 `json:"trade_timestamp"`
+	// 주문 타임스탬프 (millisecond) [Long]
+	OrderTimestamp int64 `json:"order_timestamp"`
+	// 타임스탬프 (millisecond) [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 스트림 타입 (REALTIME) [String]
+	StreamType string `json:"stream_type"`
+}
+
+// 주문 Block 으로 바꾸기
+//  yauga.OrderTracker 의 Apply 에 넣을 수 있는 형태입니다.
+//	state 가 trade 이면 체결 하나를 담은 진행 중(wait) 주문이 되며, 이때 Price 는 비어 있습니다. (체결 가격은 Trades 에, 주문 가격은 OrderTracker 가 이전 값을 유지)
+func (b UpbitWsMyOrderBlock) Order() yauga.UpbitOrderBlock {
+	order := yauga.UpbitOrderBlock{
+		Uuid:            b.Uuid,
+		Side:            strings.ToLower(b.AskBid),
+		OrdType:         b.OrderType,
+		Price:           b.Price,
+		State:           b.State,
+		Market:          b.Code,
+		CreatedAt:       time.Unix(0, b.OrderTimestamp*int64(time.Millisecond)).In(yauga.KST),
+		Volume:          b.Volume,
+		RemainingVolume: b.RemainingVolume,
+		ReservedFee:     b.ReservedFee,
+		RemainingFee:    b.RemainingFee,
+		PaidFee:         b.PaidFee,
+		Locked:          b.Locked,
+		ExecutedVolume:  b.ExecutedVolume,
+		TradeCount:      b.TradesCount,
+	}
+	if b.State == "trade" {
+		order.State = string(yauga.ORDER_STATE_WAIT)
+		order.Price = yauga.Decimal{}
+		order.Volume = order.RemainingVolume.Add(order.ExecutedVolume)
+		order.Trades = []yauga.TradeBlock{{
+			Market:    b.Code,
+			Uuid:      b.TradeUuid,
+			Price:     b.Price,
+			Volume:    b.Volume,
+			Funds:     b.Price.Mul(b.Volume),
+			Side:      order.Side,
+			CreatedAt: time.Unix(0, b.TradeTimestamp*int64(time.Millisecond)).In(yauga.KST),
+		}}
+	}
+	return order
+}
+
+// 내 자산 @ myAsset 수신 Block
+type UpbitWsMyAssetBlock struct {
+	// 타입 [String]
+	Type string `json:"type"`
+	// 자산 고유 아이디 [String]
+	AssetUuid string `json:"asset_uuid"`
+	// 자산 리스트 [List of Objects]
+	Assets []WsAssetBlock `json:"assets"`
+	// 자산 타임스탬프 (millisecond) [Long]
+	AssetTimestamp int64 `json:"asset_timestamp"`
+	// 타임스탬프 (millisecond) [Long]
+	Timestamp int64 `json:"timestamp"`
+	// 스트림 타입 (REALTIME) [String]
+	StreamType string `json:"stream_type"`
+}
+
+// 자산 Block [Object]
+type WsAssetBlock struct {
+	// 화폐를 의미하는 영문 대문자 코드 [String]
+	Currency string `json:"currency"`
+	// 주문가능 수량 [Number]
+	Balance yauga.Decimal `json:"balance"`
+	// 주문 중 묶여있는 수량 [Number]
+	Locked yauga.Decimal `json:"locked"`
+}
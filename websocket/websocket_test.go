@@ -0,0 +1,189 @@
+package websocket
+
+/**
+ * yauga_test -  Yet another Upbit API for golang / LGPL-v2.1
+ * 2022, David Jung @ github.com/davidjung-kr/yauga
+ *
+ * I am not responsible for anything done with this. YOU USE IT AT YOUR OWN RISK.
+ */
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/davidjung-kr/yauga"
+	"github.com/golang-jwt/jwt"
+	gws "github.com/gorilla/websocket"
+)
+
+// 환경변수 상에서 엑세스 데이터 취득
+//  실제 거래소에 붙는 테스트이므로 환경변수가 없으면 건너뜁니다.
+func getEnvData(t *testing.T) (string, string) {
+	yaugaAccessKey := os.Getenv("YAUGA_ACCESS_KEY")
+	yaugaSecrectKey := os.Getenv("YAUGA_SECRECT_KEY")
+	if yaugaAccessKey == "" {
+		t.Skip("Please set a `YAUGA_ACCESS_KEY`")
+	} else if yaugaSecrectKey == "" {
+		t.Skip("Please set a `YAUGA_SECRECT_KEY`")
+	}
+	return yaugaAccessKey, yaugaSecrectKey
+}
+
+// 웹소켓 메시지 분배 테스트
+func TestUpbitWebSocketDispatch(t *testing.T) {
+	ws := NewUpbitWebSocket()
+	err := ws.dispatch([]byte(`{"type":"trade","code":"KRW-BTC","trade_price":50000000.0,"trade_volume":0.01,"ask_bid":"BID","trade_date":"2022-01-01","trade_time":"00:00:00","trade_timestamp":1640995200000,"timestamp":1640995200100,"sequential_id":1640995200000000,"stream_type":"REALTIME"}`))
+	if err != nil {
+		t.Errorf("TestUpbitWebSocketDispatch | dispatchErr:[%s]", err)
+	}
+	trade := <-ws.Trade
+	if trade.Market != "KRW-BTC" || trade.TradePrice != 50000000 || trade.SequentialId != 1640995200000000 {
+		t.Errorf("TestUpbitWebSocketDispatch | Market:[%s], TradePrice:[%f], SequentialId:[%d]", trade.Market, trade.TradePrice, trade.SequentialId)
+	}
+	ws.Close()
+}
+
+// 웹소켓 현재가 수신 테스트
+func TestUpbitWebSocketTicker(t *testing.T) {
+	getEnvData(t)
+	ws := NewUpbitWebSocket()
+	defer ws.Close()
+	ws.Subscribe(STREAM_TYPE_TICKER, []string{"KRW-BTC"})
+	if err := ws.Connect(); err != nil {
+		t.Fatalf("TestUpbitWebSocketTicker | connectErr:[%s]", err)
+	}
+	select {
+	case ticker := <-ws.Ticker:
+		if ticker.Market != "KRW-BTC" || ticker.TradePrice <= 0 {
+			t.Errorf("TestUpbitWebSocketTicker | Market:[%s], TradePrice:[%f]", ticker.Market, ticker.TradePrice)
+		}
+	case err := <-ws.Error:
+		t.Errorf("TestUpbitWebSocketTicker | webSocketErr:[%s]", err)
+	case <-time.After(10 * time.Second):
+		t.Errorf("TestUpbitWebSocketTicker | timeout")
+	}
+}
+
+// 웹소켓 재연결 테스트
+//  서버가 연결을 끊으면 재연결, 재구독 후 체결 누락(gap) 이벤트를 보내고 다시 받은 체결은 버려야 함
+//	순서가 바뀌어 늦게 도착한 체결은 처음 받은 것이면 전달해야 함
+func TestUpbitWebSocketReconnect(t *testing.T) {
+	upgrader := gws.Upgrader{}
+	var connections int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		n := atomic.AddInt32(&connections, 1)
+		if n > 1 {
+			// 재연결 후 이미 받은 체결을 다시 보냄
+			for _, id := range []int{5, 10} {
+				conn.WriteMessage(gws.BinaryMessage, []byte(fmt.Sprintf(`{"type":"trade","code":"KRW-BTC","trade_price":1.0,"sequential_id":%d}`, id)))
+			}
+		}
+		conn.WriteMessage(gws.BinaryMessage, []byte(fmt.Sprintf(`{"type":"trade","code":"KRW-BTC","trade_price":1.0,"sequential_id":%d}`, n*10)))
+		if n == 1 {
+			// 순서가 바뀌어 늦게 도착한 체결
+			conn.WriteMessage(gws.BinaryMessage, []byte(`{"type":"trade","code":"KRW-BTC","trade_price":1.0,"sequential_id":5}`))
+			return
+		}
+		conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	ws := NewUpbitWebSocketFor(yauga.NewUpbit("", yauga.WithBaseURL(server.URL)))
+	defer ws.Close()
+	ws.Subscribe(STREAM_TYPE_TRADE, []string{"KRW-BTC"})
+	if err := ws.Connect(); err != nil {
+		t.Fatalf("TestUpbitWebSocketReconnect | connectErr:[%s]", err)
+	}
+
+	expected := []WsEventType{WS_EVENT_CONNECTED, WS_EVENT_DISCONNECTED, WS_EVENT_RECONNECTED, WS_EVENT_GAP}
+	for _, eventType := range expected {
+		select {
+		case event := <-ws.Event:
+			if event.Type != eventType {
+				t.Fatalf("TestUpbitWebSocketReconnect | Event:[%s], Expected:[%s]", event.Type, eventType)
+			}
+			if event.Type == WS_EVENT_GAP && (event.LastSequentialId != 10 || event.SequentialId != 20) {
+				t.Errorf("TestUpbitWebSocketReconnect | LastSequentialId:[%d], SequentialId:[%d]", event.LastSequentialId, event.SequentialId)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestUpbitWebSocketReconnect | timeout waiting [%s]", eventType)
+		}
+	}
+	for _, id := range []int64{10, 5, 20} {
+		trade := <-ws.Trade
+		if trade.SequentialId != id {
+			t.Errorf("TestUpbitWebSocketReconnect | SequentialId:[%d], Expected:[%d]", trade.SequentialId, id)
+		}
+	}
+	select {
+	case trade := <-ws.Trade:
+		t.Errorf("TestUpbitWebSocketReconnect | Replayed SequentialId:[%d]", trade.SequentialId)
+	default:
+	}
+}
+
+// 웹소켓 내 주문 수신 테스트
+//  JWT 로 인증하고 myOrder, myAsset 을 구독해야 함
+func TestUpbitPrivateWebSocket(t *testing.T) {
+	upgrader := gws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/websocket/v1/private" {
+			http.NotFound(w, r)
+			return
+		}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		_, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return []byte("secret"), nil })
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, request, err := conn.ReadMessage()
+		if err != nil || !strings.Contains(string(request), `"type":"myOrder"`) || !strings.Contains(string(request), `"type":"myAsset"`) {
+			return
+		}
+		conn.WriteMessage(gws.BinaryMessage, []byte(`{"type":"myOrder","code":"KRW-BTC","uuid":"ac2dc2a3-fce9-40a2-a4f6-5987c25c438f","ask_bid":"BID","order_type":"limit","state":"trade","trade_uuid":"t-1","price":50000000.0,"volume":0.001,"remaining_volume":0.002,"executed_volume":0.001,"paid_fee":25.0}`))
+		conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	upbit := yauga.NewUpbit("access")
+	upbit.SetSecretKey("secret")
+	upbit.SetBaseURL(server.URL)
+	ws := NewUpbitPrivateWebSocket(upbit)
+	defer ws.Close()
+	ws.Subscribe(STREAM_TYPE_MY_ORDER, nil)
+	ws.Subscribe(STREAM_TYPE_MY_ASSET, nil)
+	if err := ws.Connect(); err != nil {
+		t.Fatalf("TestUpbitPrivateWebSocket | connectErr:[%s]", err)
+	}
+	select {
+	case order := <-ws.MyOrder:
+		if order.Code != "KRW-BTC" || order.State != "trade" || !order.Volume.Equal(yauga.MustDecimal("0.001")) {
+			t.Errorf("TestUpbitPrivateWebSocket | Code:[%s], State:[%s], Volume:[%s]", order.Code, order.State, order.Volume)
+		}
+		block := order.Order()
+		if block.State != "wait" || block.Side != "bid" || !block.Volume.Equal(yauga.MustDecimal("0.003")) || len(block.Trades) != 1 || !block.Trades[0].Funds.Equal(yauga.MustDecimal("50000")) || !block.PaidFee.Equal(yauga.MustDecimal("25")) {
+			t.Errorf("TestUpbitPrivateWebSocket | State:[%s], Side:[%s], Volume:[%s], Trades:[%v], PaidFee:[%s]", block.State, block.Side, block.Volume, block.Trades, block.PaidFee)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("TestUpbitPrivateWebSocket | timeout")
+	}
+}